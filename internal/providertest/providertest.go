@@ -0,0 +1,667 @@
+// Package providertest holds the tests every provider of this module has to pass, so that the providers behave the
+// same regardless of the backend they write to.
+package providertest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Entry is a log entry as captured by the backend of the provider under test.
+type Entry struct {
+	Level   slf4go_api.LogLevel
+	Message string
+	Tags    slf4go_api.LogTags
+}
+
+// Capture returns the entries the backend captured so far, in the order they were written.
+type Capture func() []Entry
+
+// NewLogger creates a root logger of the provider under test and the capture of the entries it writes. The backend
+// has to write all levels including slf4go_api.Trace. It is called once per test, so that each test starts without
+// entries.
+type NewLogger func(t *testing.T) (slf4go_api.Slf4GoLogger, Capture)
+
+// Option configures the expectations of Run.
+type Option func(*suite)
+
+// JSONLines captures the newline-delimited JSON entries written to output, e.g. by the native or the zerolog provider.
+// Levels are parsed via slf4go_api.ParseLevel. All fields except levelKey, messageKey and ignoredKeys become tags.
+func JSONLines(output *bytes.Buffer, levelKey string, messageKey string, ignoredKeys ...string) Capture {
+	return func() []Entry {
+		var entries []Entry
+		for _, line := range bytes.Split(output.Bytes(), []byte("\n")) {
+			if len(line) == 0 {
+				continue
+			}
+			var tags slf4go_api.LogTags
+			if err := json.Unmarshal(line, &tags); err != nil {
+				panic(fmt.Sprintf("decoding %q failed: %v", line, err))
+			}
+			levelName, _ := tags[levelKey].(string)
+			level, err := slf4go_api.ParseLevel(levelName)
+			if err != nil {
+				panic(fmt.Sprintf("parsing the level of %q failed: %v", line, err))
+			}
+			message, _ := tags[messageKey].(string)
+			for _, key := range append([]string{levelKey, messageKey}, ignoredKeys...) {
+				delete(tags, key)
+			}
+			entries = append(entries, Entry{Level: level, Message: message, Tags: tags})
+		}
+		return entries
+	}
+}
+
+// CapturedAs converts the expected tags into the values the backend captures, e.g. JSONValues for backends writing
+// JSON. Tags are compared including the types of their values.
+func CapturedAs(convert func(tags slf4go_api.LogTags) slf4go_api.LogTags) Option {
+	return func(s *suite) {
+		s.captured = convert
+	}
+}
+
+// JSONValues converts tag values into the values decoding their JSON encoding yields, e.g. float64 for numbers.
+func JSONValues(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	converted := make(slf4go_api.LogTags, len(tags))
+	for key, value := range tags {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			panic(err)
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			panic(err)
+		}
+		converted[key] = decoded
+	}
+	return converted
+}
+
+// ComponentsAsStrings converts slf4go_api.AppComponent values into strings, for backends writing components as
+// plain string attributes.
+func ComponentsAsStrings(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	converted := make(slf4go_api.LogTags, len(tags))
+	for key, value := range tags {
+		if component, ok := value.(slf4go_api.AppComponent); ok {
+			value = string(component)
+		}
+		converted[key] = value
+	}
+	return converted
+}
+
+type suite struct {
+	newLogger NewLogger
+	captured  func(tags slf4go_api.LogTags) slf4go_api.LogTags
+}
+
+// Run runs the tests every provider has to pass: the logging methods of all levels on derived loggers, the
+// precedence of static, error, context and dynamic tags, errors, lazy values, ComponentLevels and caller reporting.
+// Providers add tests for the specifics of their backend, e.g. backend levels, encoding and the message logged for
+// unknown levels.
+func Run(t *testing.T, newLogger NewLogger, options ...Option) {
+	s := &suite{newLogger: newLogger, captured: func(tags slf4go_api.LogTags) slf4go_api.LogTags { return tags }}
+	for _, option := range options {
+		option(s)
+	}
+	t.Run("levels", s.testLevels)
+	t.Run("unknown-level", s.testUnknownLevel)
+	t.Run("context", s.testContext)
+	t.Run("static-tags", s.testStaticTags)
+	t.Run("errors", s.testErrors)
+	t.Run("lazy", s.testLazy)
+	t.Run("component-levels", s.testComponentLevels)
+	t.Run("caller", s.testCaller)
+}
+
+// levelNames holds the names of the levelMethods, in the same order.
+var levelNames = []string{"fatal", "panic", "error", "warn", "warning", "info", "debug", "trace"}
+
+// levelMethod holds the logging methods of a level, bound to a logger.
+type levelMethod struct {
+	level           slf4go_api.LogLevel
+	logf            func(msgTemplate string, args ...interface{})
+	logWithTagsf    func(tags slf4go_api.LogTags, msgTemplate string, args ...interface{})
+	logCtxf         func(ctx context.Context, msgTemplate string, args ...interface{})
+	logWithTagsCtxf func(ctx context.Context, tags slf4go_api.LogTags, msgTemplate string, args ...interface{})
+}
+
+func levelMethods(logger slf4go_api.Slf4GoLogger) []levelMethod {
+	return []levelMethod{
+		{slf4go_api.Fatal, logger.Fatalf, logger.FatalWithTagsf, logger.FatalCtxf, logger.FatalWithTagsCtxf},
+		{slf4go_api.Panic, logger.Panicf, logger.PanicWithTagsf, logger.PanicCtxf, logger.PanicWithTagsCtxf},
+		{slf4go_api.Error, logger.Errorf, logger.ErrorWithTagsf, logger.ErrorCtxf, logger.ErrorWithTagsCtxf},
+		{slf4go_api.Warn, logger.Warnf, logger.WarnWithTagsf, logger.WarnCtxf, logger.WarnWithTagsCtxf},
+		{slf4go_api.Warn, logger.Warningf, logger.WarningWithTagsf, logger.WarningCtxf, logger.WarningWithTagsCtxf},
+		{slf4go_api.Info, logger.Infof, logger.InfoWithTagsf, logger.InfoCtxf, logger.InfoWithTagsCtxf},
+		{slf4go_api.Debug, logger.Debugf, logger.DebugWithTagsf, logger.DebugCtxf, logger.DebugWithTagsCtxf},
+		{slf4go_api.Trace, logger.Tracef, logger.TraceWithTagsf, logger.TraceCtxf, logger.TraceWithTagsCtxf},
+	}
+}
+
+// derivation derives a logger as done by applications and lists the tags the derived logger adds to entries.
+type derivation struct {
+	name   string
+	derive func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger
+	tags   slf4go_api.LogTags
+}
+
+const testComponent slf4go_api.AppComponent = "test-service"
+
+var staticTags = slf4go_api.LogTags{"key1": "val1", "key2": "val2"}
+
+var derivations = []derivation{
+	{"root", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger
+	}, slf4go_api.LogTags{}},
+	{"for-component", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(testComponent)
+	}, slf4go_api.LogTags{slf4go_api.DefaultAppComponentTag: testComponent}},
+	{"with-component-label", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithAppComponentLabel("appLabel")
+	}, slf4go_api.LogTags{}},
+	{"with-static-tags", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithStaticTags(staticTags)
+	}, staticTags},
+	{"with-component-label-with-static-tags", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithAppComponentLabel("appLabel").WithStaticTags(staticTags)
+	}, staticTags},
+	{"for-component-with-component-label", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(testComponent).WithAppComponentLabel("appLabel")
+	}, slf4go_api.LogTags{"appLabel": testComponent}},
+	{"for-component-with-static-tags", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(testComponent).WithStaticTags(staticTags)
+	}, combineTags(staticTags, slf4go_api.LogTags{slf4go_api.DefaultAppComponentTag: testComponent})},
+	{"for-component-with-component-label-with-static-tags", func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(testComponent).WithAppComponentLabel("appLabel").WithStaticTags(staticTags)
+	}, combineTags(staticTags, slf4go_api.LogTags{"appLabel": testComponent})},
+}
+
+func (s *suite) testLevels(t *testing.T) {
+	dynamicTags := slf4go_api.LogTags{"dyn_key1": "dyn_val1", "dyn_key2": 42}
+	variants := []struct {
+		name     string
+		log      func(method levelMethod)
+		tags     slf4go_api.LogTags
+		expected string
+	}{
+		{"base", func(method levelMethod) {
+			method.logf("test message")
+		}, slf4go_api.LogTags{}, "test message"},
+		{"dyntags", func(method levelMethod) {
+			method.logWithTagsf(dynamicTags, "test message")
+		}, dynamicTags, "test message"},
+		{"formatted", func(method levelMethod) {
+			method.logf("test message with name=%s and value=%d", "beeblebrox", 42)
+		}, slf4go_api.LogTags{}, "test message with name=beeblebrox and value=42"},
+		{"dyntags-formatted", func(method levelMethod) {
+			method.logWithTagsf(dynamicTags, "test message with name=%s and value=%d", "beeblebrox", 42)
+		}, dynamicTags, "test message with name=beeblebrox and value=42"},
+		{"ctx", func(method levelMethod) {
+			method.logCtxf(context.Background(), "test message")
+		}, slf4go_api.LogTags{}, "test message"},
+		{"dyntags-ctx", func(method levelMethod) {
+			method.logWithTagsCtxf(context.Background(), dynamicTags, "test message")
+		}, dynamicTags, "test message"},
+	}
+
+	for _, derivation := range derivations {
+		for i, name := range levelNames {
+			for _, variant := range variants {
+				t.Run(derivation.name+"/"+name+"-"+variant.name, func(t *testing.T) {
+					logger, capture := s.newLogger(t)
+					level := levelMethods(logger)[i].level
+					logSafely(t, level, derivation.derive(logger), func(logger slf4go_api.Slf4GoLogger) {
+						variant.log(levelMethods(logger)[i])
+					})
+					s.assertSingleEntry(t, capture, level, combineTags(derivation.tags, variant.tags), variant.expected)
+				})
+			}
+		}
+	}
+}
+
+func (s *suite) testUnknownLevel(t *testing.T) {
+	const unknownLevel slf4go_api.LogLevel = 666
+
+	t.Run("not-enabled", func(t *testing.T) {
+		logger, _ := s.newLogger(t)
+		assert.False(t, logger.IsEnabled(unknownLevel))
+	})
+
+	scenarios := []struct {
+		name string
+		log  func(logger slf4go_api.Slf4GoLogger)
+	}{
+		{"logf", func(logger slf4go_api.Slf4GoLogger) {
+			logger.Logf(unknownLevel, "Some message not displayed")
+		}},
+		{"log-ctxf", func(logger slf4go_api.Slf4GoLogger) {
+			logger.LogCtxf(context.Background(), unknownLevel, "Some message not displayed")
+		}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			logger, capture := s.newLogger(t)
+			scenario.log(logger.ForComponent(testComponent).WithStaticTags(staticTags))
+			entries := capture()
+			require.Len(t, entries, 1)
+			assert.Equal(t, slf4go_api.Error, entries[0].Level)
+			assert.Contains(t, entries[0].Message, "'unknown'")
+		})
+	}
+}
+
+func (s *suite) testContext(t *testing.T) {
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"ctx_key1": "ctx_val1", "ctx_key2": "ctx_val2"})
+	derive := func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(testComponent).WithStaticTags(slf4go_api.LogTags{"key1": "val1", "ctx_key2": "static_val2"})
+	}
+
+	for i, name := range levelNames {
+		t.Run(name+"-ctx", func(t *testing.T) {
+			logger, capture := s.newLogger(t)
+			level := levelMethods(logger)[i].level
+			logSafely(t, level, derive(logger), func(logger slf4go_api.Slf4GoLogger) {
+				levelMethods(logger)[i].logCtxf(ctx, "test message with name=%s and value=%d", "beeblebrox", 42)
+			})
+			s.assertSingleEntry(t, capture, level, slf4go_api.LogTags{
+				"key1":                            "val1",
+				"ctx_key1":                        "ctx_val1",
+				"ctx_key2":                        "ctx_val2",
+				slf4go_api.DefaultAppComponentTag: testComponent,
+			}, "test message with name=beeblebrox and value=42")
+		})
+
+		t.Run(name+"-dyntags-ctx", func(t *testing.T) {
+			logger, capture := s.newLogger(t)
+			level := levelMethods(logger)[i].level
+			logSafely(t, level, derive(logger), func(logger slf4go_api.Slf4GoLogger) {
+				levelMethods(logger)[i].logWithTagsCtxf(ctx, slf4go_api.LogTags{"dyn_key1": "dyn_val1", "ctx_key1": "dyn_val2"}, "test message")
+			})
+			s.assertSingleEntry(t, capture, level, slf4go_api.LogTags{
+				"key1":                            "val1",
+				"dyn_key1":                        "dyn_val1",
+				"ctx_key1":                        "dyn_val2",
+				"ctx_key2":                        "ctx_val2",
+				slf4go_api.DefaultAppComponentTag: testComponent,
+			}, "test message")
+		})
+	}
+
+	t.Run("nil-context", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		//lint:ignore SA1012 a nil context must be tolerated
+		derive(logger).LogCtxf(nil, slf4go_api.Info, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{
+			"key1":                            "val1",
+			"ctx_key2":                        "static_val2",
+			slf4go_api.DefaultAppComponentTag: testComponent,
+		}, "test message")
+	})
+}
+
+func (s *suite) testStaticTags(t *testing.T) {
+	t.Run("replaced-by-derived-logger", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WithStaticTags(staticTags).WithStaticTags(slf4go_api.LogTags{"key2": "replaced"}).Infof("test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{"key2": "replaced"}, "test message")
+	})
+
+	t.Run("added-by-derived-logger", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		slf4go_api.AddStaticTags(logger.WithStaticTags(staticTags), slf4go_api.LogTags{"key2": "added", "key3": "val3"}).Infof("test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{"key1": "val1", "key2": "added", "key3": "val3"}, "test message")
+	})
+
+	t.Run("dynamic-tags-take-precedence", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WithStaticTags(staticTags).InfoWithTagsf(slf4go_api.LogTags{"key1": "dyn_val1"}, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{"key1": "dyn_val1", "key2": "val2"}, "test message")
+	})
+}
+
+func (s *suite) testErrors(t *testing.T) {
+	rootCause := errors.New("connection refused")
+	err := fmt.Errorf("query failed: %w", rootCause)
+
+	t.Run("with-error", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.ForComponent(testComponent).WithStaticTags(slf4go_api.LogTags{"key1": "val1"}).
+			WithError(err).InfoWithTagsf(slf4go_api.LogTags{"key2": "val2"}, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{
+			"key1":                            "val1",
+			"key2":                            "val2",
+			slf4go_api.ErrorTag:               "query failed: connection refused",
+			slf4go_api.ErrorChainTag:          []string{"query failed: connection refused", "connection refused"},
+			slf4go_api.DefaultAppComponentTag: testComponent,
+		}, "test message")
+	})
+
+	t.Run("errf", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.ErrorErrf(rootCause, "test message with name=%s", "beeblebrox")
+		s.assertSingleEntry(t, capture, slf4go_api.Error, slf4go_api.LogTags{slf4go_api.ErrorTag: "connection refused"},
+			"test message with name=beeblebrox")
+	})
+
+	t.Run("errf-of-all-levels", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logSafely(t, slf4go_api.Fatal, logger, func(logger slf4go_api.Slf4GoLogger) { logger.FatalErrf(rootCause, "fatal") })
+		logSafely(t, slf4go_api.Panic, logger, func(logger slf4go_api.Slf4GoLogger) { logger.PanicErrf(rootCause, "panic") })
+		logger.ErrorErrf(rootCause, "error")
+		logger.WarnErrf(rootCause, "warn")
+		logger.WarningErrf(rootCause, "warning")
+		logger.LogErrf(slf4go_api.Debug, rootCause, "debug")
+
+		expectedTags := s.captured(slf4go_api.LogTags{slf4go_api.ErrorTag: "connection refused"})
+		var levels []slf4go_api.LogLevel
+		for _, entry := range capture() {
+			levels = append(levels, entry.Level)
+			assert.Equal(t, expectedTags, entry.Tags, entry.Message)
+		}
+		assert.Equal(t, []slf4go_api.LogLevel{
+			slf4go_api.Fatal, slf4go_api.Panic, slf4go_api.Error, slf4go_api.Warn, slf4go_api.Warn, slf4go_api.Debug,
+		}, levels)
+	})
+
+	t.Run("derived-loggers-keep-error", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WithError(rootCause).ForComponent(testComponent).Warnf("test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Warn, slf4go_api.LogTags{
+			slf4go_api.ErrorTag:               "connection refused",
+			slf4go_api.DefaultAppComponentTag: testComponent,
+		}, "test message")
+	})
+
+	t.Run("dynamic-tags-take-precedence", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WithError(rootCause).ForComponent(testComponent).
+			WarnWithTagsf(slf4go_api.LogTags{slf4go_api.ErrorTag: "overridden"}, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Warn, slf4go_api.LogTags{
+			slf4go_api.ErrorTag:               "overridden",
+			slf4go_api.DefaultAppComponentTag: testComponent,
+		}, "test message")
+	})
+
+	t.Run("nil-error", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WarningErrf(nil, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Warn, slf4go_api.LogTags{}, "test message")
+	})
+}
+
+func (s *suite) testLazy(t *testing.T) {
+	evaluations := 0
+	lazyArg := slf4go_api.Lazy(func() interface{} {
+		evaluations++
+		return "beeblebrox"
+	})
+	lazyTags := slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags {
+		evaluations++
+		return slf4go_api.LogTags{"lazy_key1": "lazy_val1", "dyn_key1": "overridden"}
+	})
+	lazyValue := slf4go_api.Lazy(func() interface{} {
+		evaluations++
+		return "lazy_val2"
+	})
+	dynamicTags := slf4go_api.LogTags{"lazy": lazyTags, "dyn_key1": "dyn_val1"}
+	newLazyLogger := func(t *testing.T) (slf4go_api.Slf4GoLogger, Capture) {
+		logger, capture := s.newLogger(t)
+		return logger.
+			WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Info)).
+			WithStaticTags(slf4go_api.LogTags{"lazy_key2": lazyValue}), capture
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		evaluations = 0
+		logger, capture := newLazyLogger(t)
+		logger.DebugWithTagsf(dynamicTags, "test message with name=%s", lazyArg)
+		logger.TraceCtxf(context.Background(), "test message with name=%s", lazyArg)
+		assert.Equal(t, 0, evaluations)
+		assert.Empty(t, capture())
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		evaluations = 0
+		logger, capture := newLazyLogger(t)
+		logger.InfoWithTagsf(dynamicTags, "test message with name=%s", lazyArg)
+		assert.Equal(t, 3, evaluations)
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{
+			"dyn_key1":  "dyn_val1",
+			"lazy_key1": "lazy_val1",
+			"lazy_key2": "lazy_val2",
+		}, "test message with name=beeblebrox")
+	})
+
+	t.Run("explicit-static-tags-take-precedence", func(t *testing.T) {
+		logger, capture := s.newLogger(t)
+		logger.WithStaticTags(slf4go_api.LogTags{"key1": "val1"}).InfoWithTagsf(slf4go_api.LogTags{
+			"lazy": slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags {
+				return slf4go_api.LogTags{"key1": "overridden", "lazy_key1": "lazy_val1"}
+			}),
+		}, "test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Info, slf4go_api.LogTags{"key1": "val1", "lazy_key1": "lazy_val1"}, "test message")
+	})
+}
+
+func (s *suite) testComponentLevels(t *testing.T) {
+	newLeveledLogger := func(t *testing.T) (slf4go_api.Slf4GoLogger, *slf4go_api.ComponentLevels, Capture) {
+		logger, capture := s.newLogger(t)
+		levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+		levels.SetLevel("payment-gateway", slf4go_api.Trace)
+		return logger.WithComponentLevels(levels), levels, capture
+	}
+
+	t.Run("all-levels-enabled-without", func(t *testing.T) {
+		logger, _ := s.newLogger(t)
+		for _, level := range slf4go_api.AllLevels {
+			assert.True(t, logger.ForComponent("user-service").IsEnabled(level), level.String())
+		}
+		assert.True(t, logger.IsDebugEnabled())
+		assert.True(t, logger.IsTraceEnabled())
+	})
+
+	t.Run("default-level", func(t *testing.T) {
+		logger, _, capture := newLeveledLogger(t)
+		componentLogger := logger.ForComponent("user-service")
+		assert.True(t, componentLogger.IsEnabled(slf4go_api.Info))
+		assert.False(t, componentLogger.IsDebugEnabled())
+		assert.False(t, componentLogger.IsTraceEnabled())
+		componentLogger.DebugWithTagsf(slf4go_api.LogTags{"key1": "val1"}, "test message")
+		componentLogger.TraceCtxf(context.Background(), "test message")
+		assert.Empty(t, capture())
+	})
+
+	t.Run("override", func(t *testing.T) {
+		logger, _, capture := newLeveledLogger(t)
+		componentLogger := logger.ForComponent("payment-gateway")
+		assert.True(t, componentLogger.IsTraceEnabled())
+		componentLogger.Tracef("test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Trace,
+			slf4go_api.LogTags{slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("payment-gateway")}, "test message")
+	})
+
+	t.Run("registered-on-creation", func(t *testing.T) {
+		logger, levels, _ := newLeveledLogger(t)
+		logger.ForComponent("user-service")
+		logger.ForComponent("billing").WithComponentLevels(levels).IsEnabled(slf4go_api.Info)
+		assert.Equal(t, []slf4go_api.AppComponent{"billing", "payment-gateway", "user-service"}, levels.Components())
+	})
+
+	t.Run("changed-at-runtime", func(t *testing.T) {
+		logger, levels, capture := newLeveledLogger(t)
+		componentLogger := logger.ForComponent("user-service")
+		levels.SetLevel("user-service", slf4go_api.Debug)
+		componentLogger.Debugf("test message")
+		s.assertSingleEntry(t, capture, slf4go_api.Debug,
+			slf4go_api.LogTags{slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("user-service")}, "test message")
+	})
+
+	t.Run("disabled-without-extracting-context-tags", func(t *testing.T) {
+		logger, _, capture := newLeveledLogger(t)
+		extractions := 0
+		unregister := slf4go_api.RegisterContextTagExtractor(func(ctx context.Context) slf4go_api.LogTags {
+			extractions++
+			return nil
+		})
+		defer unregister()
+		logger.DebugCtxf(context.Background(), "test message")
+		logger.TraceWithTagsCtxf(context.Background(), slf4go_api.LogTags{"key1": "val1"}, "test message")
+		assert.Equal(t, 0, extractions)
+		assert.Empty(t, capture())
+	})
+
+	t.Run("fatal-and-panic-regardless-of-level", func(t *testing.T) {
+		logger, levels, _ := newLeveledLogger(t)
+		levels.SetDefaultLevel(slf4go_api.Fatal)
+		assert.IsType(t, &slf4go_api.FatalError{}, slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+			logger.Fatalf("test message")
+		}))
+		assert.Panics(t, func() { logger.Panicf("test message") })
+	})
+}
+
+func (s *suite) testCaller(t *testing.T) {
+	ctx := context.Background()
+	tags := slf4go_api.LogTags{"key1": "val1"}
+	err := errors.New("test error")
+	var logger slf4go_api.Slf4GoLogger
+
+	scenarios := []struct {
+		name  string
+		logFn func()
+		line  int
+	}{
+		{"Logf", func() { logger.Logf(slf4go_api.Info, "test message") }, currentLine()},
+		{"LogWithTagsf", func() { logger.LogWithTagsf(slf4go_api.Info, tags, "test message") }, currentLine()},
+		{"Tracef", func() { logger.Tracef("test message") }, currentLine()},
+		{"Debugf", func() { logger.Debugf("test message") }, currentLine()},
+		{"Infof", func() { logger.Infof("test message") }, currentLine()},
+		{"Warnf", func() { logger.Warnf("test message") }, currentLine()},
+		{"Warningf", func() { logger.Warningf("test message") }, currentLine()},
+		{"Errorf", func() { logger.Errorf("test message") }, currentLine()},
+		{"Panicf", func() { logger.Panicf("test message") }, currentLine()},
+		{"Fatalf", func() { logger.Fatalf("test message") }, currentLine()},
+		{"TraceWithTagsf", func() { logger.TraceWithTagsf(tags, "test message") }, currentLine()},
+		{"DebugWithTagsf", func() { logger.DebugWithTagsf(tags, "test message") }, currentLine()},
+		{"InfoWithTagsf", func() { logger.InfoWithTagsf(tags, "test message") }, currentLine()},
+		{"WarnWithTagsf", func() { logger.WarnWithTagsf(tags, "test message") }, currentLine()},
+		{"WarningWithTagsf", func() { logger.WarningWithTagsf(tags, "test message") }, currentLine()},
+		{"ErrorWithTagsf", func() { logger.ErrorWithTagsf(tags, "test message") }, currentLine()},
+		{"PanicWithTagsf", func() { logger.PanicWithTagsf(tags, "test message") }, currentLine()},
+		{"FatalWithTagsf", func() { logger.FatalWithTagsf(tags, "test message") }, currentLine()},
+		{"LogErrf", func() { logger.LogErrf(slf4go_api.Error, err, "test message") }, currentLine()},
+		{"WarnErrf", func() { logger.WarnErrf(err, "test message") }, currentLine()},
+		{"WarningErrf", func() { logger.WarningErrf(err, "test message") }, currentLine()},
+		{"ErrorErrf", func() { logger.ErrorErrf(err, "test message") }, currentLine()},
+		{"PanicErrf", func() { logger.PanicErrf(err, "test message") }, currentLine()},
+		{"FatalErrf", func() { logger.FatalErrf(err, "test message") }, currentLine()},
+		{"LogCtxf", func() { logger.LogCtxf(ctx, slf4go_api.Info, "test message") }, currentLine()},
+		{"LogWithTagsCtxf", func() { logger.LogWithTagsCtxf(ctx, slf4go_api.Info, tags, "test message") }, currentLine()},
+		{"TraceCtxf", func() { logger.TraceCtxf(ctx, "test message") }, currentLine()},
+		{"DebugCtxf", func() { logger.DebugCtxf(ctx, "test message") }, currentLine()},
+		{"InfoCtxf", func() { logger.InfoCtxf(ctx, "test message") }, currentLine()},
+		{"WarnCtxf", func() { logger.WarnCtxf(ctx, "test message") }, currentLine()},
+		{"WarningCtxf", func() { logger.WarningCtxf(ctx, "test message") }, currentLine()},
+		{"ErrorCtxf", func() { logger.ErrorCtxf(ctx, "test message") }, currentLine()},
+		{"PanicCtxf", func() { logger.PanicCtxf(ctx, "test message") }, currentLine()},
+		{"FatalCtxf", func() { logger.FatalCtxf(ctx, "test message") }, currentLine()},
+		{"TraceWithTagsCtxf", func() { logger.TraceWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"DebugWithTagsCtxf", func() { logger.DebugWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"InfoWithTagsCtxf", func() { logger.InfoWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"WarnWithTagsCtxf", func() { logger.WarnWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"WarningWithTagsCtxf", func() { logger.WarningWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"ErrorWithTagsCtxf", func() { logger.ErrorWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"PanicWithTagsCtxf", func() { logger.PanicWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"FatalWithTagsCtxf", func() { logger.FatalWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"derived", func() {
+			logger.ForComponent(testComponent).WithStaticTags(tags).WithError(err).Infof("test message")
+		}, currentLine() - 1},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			root, capture := s.newLogger(t)
+			_ = slf4go_api.CatchFatal(root.WithCallerReporting(true), func(derived slf4go_api.Slf4GoLogger) {
+				logger = derived
+				defer func() { _ = recover() }()
+				scenario.logFn()
+			})
+			entries := capture()
+			require.Len(t, entries, 1)
+			assertCaller(t, entries[0], scenario.line)
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		root, capture := s.newLogger(t)
+		root.Infof("test message")
+		root.WithCallerReporting(true).WithCallerReporting(false).Infof("test message")
+		for _, entry := range capture() {
+			assert.NotContains(t, entry.Tags, slf4go_api.CallerTag)
+			assert.NotContains(t, entry.Tags, slf4go_api.FunctionTag)
+		}
+	})
+}
+
+// assertCaller asserts that the entry reports the given line of this file as call site, within testCaller.
+func assertCaller(t *testing.T, entry Entry, line int) {
+	t.Helper()
+	_, file, _, _ := runtime.Caller(0)
+	assert.Equal(t, fmt.Sprintf("%s:%d", file, line), entry.Tags[slf4go_api.CallerTag])
+	function := fmt.Sprint(entry.Tags[slf4go_api.FunctionTag])
+	assert.True(t, strings.HasPrefix(function, "github.com/MariusSchmidt/slf4go/internal/providertest.(*suite).testCaller."),
+		"unexpected function %s", function)
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
+
+// logSafely logs via logFn with the given logger, catching the program exit of Fatal entries and the panic of
+// Panic entries.
+func logSafely(t *testing.T, level slf4go_api.LogLevel, logger slf4go_api.Slf4GoLogger, logFn func(logger slf4go_api.Slf4GoLogger)) {
+	t.Helper()
+	switch level {
+	case slf4go_api.Fatal:
+		assert.IsType(t, &slf4go_api.FatalError{}, slf4go_api.CatchFatal(logger, logFn), "Fatal entry should have terminated the program")
+	case slf4go_api.Panic:
+		assert.Panics(t, func() { logFn(logger) })
+	default:
+		logFn(logger)
+	}
+}
+
+// assertSingleEntry asserts that exactly one entry has been captured, with the given level, message and tags.
+// The tags are converted into the values the backend captures and compared including their types.
+func (s *suite) assertSingleEntry(t *testing.T, capture Capture, level slf4go_api.LogLevel, tags slf4go_api.LogTags, message string) {
+	t.Helper()
+	entries := capture()
+	require.Len(t, entries, 1)
+	assert.Equal(t, Entry{Level: level, Message: message, Tags: s.captured(tags)}, entries[0])
+}
+
+func combineTags(tagSets ...slf4go_api.LogTags) slf4go_api.LogTags {
+	combined := make(slf4go_api.LogTags)
+	for _, tags := range tagSets {
+		for key, value := range tags {
+			combined[key] = value
+		}
+	}
+	return combined
+}
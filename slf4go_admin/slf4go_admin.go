@@ -0,0 +1,275 @@
+package slf4go_admin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// MaxRequestBytes is the maximum size of the body of a request changing a level.
+const MaxRequestBytes = 64 << 10
+
+// BackendLevel gives access to the level of a logging backend, e.g. the level of the *logrus.Logger
+// behind a Slf4GoLogrusLogger, which implements this interface.
+type BackendLevel interface {
+	Level() slf4go_api.LogLevel
+	SetLevel(level slf4go_api.LogLevel)
+}
+
+// Handler is an http.Handler to inspect and change log levels at runtime.
+//
+// GET returns the backend level (if configured), the default level and the effective level of all known
+// components as JSON. Known components are those of the ComponentLevels and the names of all loggers
+// handed out by slf4go_api.GetLogger.
+//
+// PUT and POST change a level and expect a JSON document like
+//
+//	{"component": "payment-gateway", "level": "trace", "ttl": "15m"}
+//
+// An empty or missing component changes the default level, "backend": true changes the backend level instead.
+// If a ttl is given, the previous level is restored after it has elapsed. The response contains the new levels
+// along with the times temporary levels are reverted at. Request bodies are limited to MaxRequestBytes.
+type Handler struct {
+	levels       func() *slf4go_api.ComponentLevels
+	backendLevel BackendLevel
+	mutex        sync.Mutex
+	reverts      map[target]*pendingRevert
+	afterFunc    func(duration time.Duration, f func()) (stop func() bool)
+}
+
+// Option configures a Handler created by NewHandler.
+type Option func(handler *Handler)
+
+// WithBackendLevel allows inspecting and changing the level of the given backend.
+func WithBackendLevel(backendLevel BackendLevel) Option {
+	return func(handler *Handler) {
+		handler.backendLevel = backendLevel
+	}
+}
+
+// target identifies a changeable level: the backend level, the default level (empty component) or a component.
+type target struct {
+	backend   bool
+	component slf4go_api.AppComponent
+}
+
+// pendingRevert restores the level a target had before a change with ttl.
+type pendingRevert struct {
+	stop   func() bool
+	revert func()
+	at     time.Time
+}
+
+// NewHandler creates a new Handler inspecting and changing the given ComponentLevels.
+func NewHandler(levels *slf4go_api.ComponentLevels, options ...Option) *Handler {
+	return NewHandlerFunc(func() *slf4go_api.ComponentLevels { return levels }, options...)
+}
+
+// NewHandlerFunc creates a new Handler inspecting and changing the ComponentLevels returned by levels, which is
+// called for every request. It serves levels that are replaced at runtime, e.g. those of slf4go_config.Watcher.
+func NewHandlerFunc(levels func() *slf4go_api.ComponentLevels, options ...Option) *Handler {
+	handler := &Handler{
+		levels:  levels,
+		reverts: make(map[target]*pendingRevert),
+		afterFunc: func(duration time.Duration, f func()) func() bool {
+			return time.AfterFunc(duration, f).Stop
+		},
+	}
+	for _, option := range options {
+		option(handler)
+	}
+	return handler
+}
+
+// LevelsDocument is the JSON document returned by the Handler.
+type LevelsDocument struct {
+	BackendLevel string `json:"backendLevel,omitempty"`
+	// BackendRevertAt is the time a temporary backend level is reverted at.
+	BackendRevertAt *time.Time `json:"backendRevertAt,omitempty"`
+	DefaultLevel    string     `json:"defaultLevel"`
+	// RevertAt is the time a temporary default level is reverted at.
+	RevertAt   *time.Time       `json:"revertAt,omitempty"`
+	Components []ComponentLevel `json:"components"`
+}
+
+// ComponentLevel describes the effective level of a single component.
+type ComponentLevel struct {
+	Component slf4go_api.AppComponent `json:"component"`
+	Level     string                  `json:"level"`
+	Override  bool                    `json:"override"`
+	RevertAt  *time.Time              `json:"revertAt,omitempty"`
+}
+
+// ChangeRequest is the JSON document expected by the Handler to change a level.
+type ChangeRequest struct {
+	Backend   bool                    `json:"backend,omitempty"`
+	Component slf4go_api.AppComponent `json:"component,omitempty"`
+	Level     string                  `json:"level"`
+	TTL       string                  `json:"ttl,omitempty"`
+}
+
+func (h *Handler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	switch request.Method {
+	case http.MethodGet:
+		h.writeLevels(writer)
+	case http.MethodPut, http.MethodPost:
+		var change ChangeRequest
+		if err := json.NewDecoder(http.MaxBytesReader(writer, request.Body, MaxRequestBytes)).Decode(&change); err != nil {
+			status := http.StatusBadRequest
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				status = http.StatusRequestEntityTooLarge
+			}
+			http.Error(writer, fmt.Sprintf("invalid request: %v", err), status)
+			return
+		}
+		if err := h.change(change); err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+			return
+		}
+		h.writeLevels(writer)
+	default:
+		writer.Header().Set("Allow", "GET, PUT, POST")
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) change(change ChangeRequest) error {
+	level, err := slf4go_api.ParseLevel(change.Level)
+	if err != nil {
+		return err
+	}
+	var ttl time.Duration
+	if change.TTL != "" {
+		if ttl, err = time.ParseDuration(change.TTL); err != nil || ttl <= 0 {
+			return fmt.Errorf("invalid ttl '%s'", change.TTL)
+		}
+	}
+	if change.Backend && h.backendLevel == nil {
+		return fmt.Errorf("no backend level configured")
+	}
+	t := target{backend: change.Backend, component: change.Component}
+	if change.Backend {
+		t.component = ""
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	revert := h.revertFunc(t)
+	if pending, ok := h.reverts[t]; ok {
+		// a pending revert restores the level from before the first temporary change
+		pending.stop()
+		revert = pending.revert
+		delete(h.reverts, t)
+	}
+	h.setLevel(t, level)
+	if ttl > 0 {
+		pending := &pendingRevert{revert: revert, at: time.Now().Add(ttl)}
+		pending.stop = h.afterFunc(ttl, func() {
+			h.mutex.Lock()
+			defer h.mutex.Unlock()
+			if h.reverts[t] == pending {
+				pending.revert()
+				delete(h.reverts, t)
+			}
+		})
+		h.reverts[t] = pending
+	}
+	return nil
+}
+
+// revertFunc returns a function restoring the current level of the given target.
+func (h *Handler) revertFunc(t target) func() {
+	switch {
+	case t.backend:
+		level := h.backendLevel.Level()
+		return func() { h.backendLevel.SetLevel(level) }
+	case t.component == "":
+		levels := h.levels()
+		level := levels.DefaultLevel()
+		return func() { levels.SetDefaultLevel(level) }
+	default:
+		levels := h.levels()
+		level, override := levels.Overrides()[t.component]
+		if !override {
+			return func() { levels.ResetLevel(t.component) }
+		}
+		return func() { levels.SetLevel(t.component, level) }
+	}
+}
+
+func (h *Handler) setLevel(t target, level slf4go_api.LogLevel) {
+	switch {
+	case t.backend:
+		h.backendLevel.SetLevel(level)
+	case t.component == "":
+		h.levels().SetDefaultLevel(level)
+	default:
+		h.levels().SetLevel(t.component, level)
+	}
+}
+
+func (h *Handler) writeLevels(writer http.ResponseWriter) {
+	writer.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(writer).Encode(h.document())
+}
+
+func (h *Handler) document() LevelsDocument {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	levels := h.levels()
+	document := LevelsDocument{
+		DefaultLevel: levels.DefaultLevel().String(),
+		RevertAt:     h.revertAt(target{}),
+		Components:   []ComponentLevel{},
+	}
+	if h.backendLevel != nil {
+		document.BackendLevel = h.backendLevel.Level().String()
+		document.BackendRevertAt = h.revertAt(target{backend: true})
+	}
+	overrides := levels.Overrides()
+	for _, component := range knownComponents(levels) {
+		_, override := overrides[component]
+		document.Components = append(document.Components, ComponentLevel{
+			Component: component,
+			Level:     levels.Level(component).String(),
+			Override:  override,
+			RevertAt:  h.revertAt(target{component: component}),
+		})
+	}
+	return document
+}
+
+func (h *Handler) revertAt(t target) *time.Time {
+	if pending, ok := h.reverts[t]; ok {
+		return &pending.at
+	}
+	return nil
+}
+
+// knownComponents returns the components of the given levels and the names of all GetLogger loggers, sorted.
+func knownComponents(levels *slf4go_api.ComponentLevels) []slf4go_api.AppComponent {
+	known := make(map[slf4go_api.AppComponent]bool)
+	for _, component := range levels.Components() {
+		known[component] = true
+	}
+	for _, name := range slf4go_api.LoggerNames() {
+		if name != slf4go_api.RootLoggerName {
+			known[slf4go_api.AppComponent(name)] = true
+		}
+	}
+	components := make([]slf4go_api.AppComponent, 0, len(known))
+	for component := range known {
+		components = append(components, component)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i] < components[j]
+	})
+	return components
+}
@@ -0,0 +1,231 @@
+package slf4go_admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_logrus_provider"
+)
+
+// fakeTimers replaces time.AfterFunc, so tests can expire ttls without waiting.
+type fakeTimers struct {
+	durations []time.Duration
+	expire    []func()
+}
+
+func (f *fakeTimers) afterFunc(duration time.Duration, expire func()) func() bool {
+	f.durations = append(f.durations, duration)
+	f.expire = append(f.expire, expire)
+	return func() bool { return true }
+}
+
+func newTestHandler(levels *slf4go_api.ComponentLevels, options ...Option) (*Handler, *fakeTimers) {
+	handler := NewHandler(levels, options...)
+	timers := &fakeTimers{}
+	handler.afterFunc = timers.afterFunc
+	return handler, timers
+}
+
+func serve(t *testing.T, handler http.Handler, method string, body string) (int, LevelsDocument) {
+	request := httptest.NewRequest(method, "/loggers", strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+	var document LevelsDocument
+	if recorder.Code == http.StatusOK {
+		assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &document))
+	}
+	return recorder.Code, document
+}
+
+func componentLevel(document LevelsDocument, component slf4go_api.AppComponent) *ComponentLevel {
+	for _, level := range document.Components {
+		if level.Component == component {
+			return &level
+		}
+	}
+	return nil
+}
+
+func TestHandler_Get(t *testing.T) {
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	levels.SetLevel("payment-gateway", slf4go_api.Trace)
+	levels.Register("user-service")
+	slf4go_api.GetLogger("billing.invoice")
+	handler, _ := newTestHandler(levels)
+
+	code, document := serve(t, handler, http.MethodGet, "")
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, LevelsDocument{
+		DefaultLevel: "info",
+		Components: []ComponentLevel{
+			{Component: "billing.invoice", Level: "info"},
+			{Component: "payment-gateway", Level: "trace", Override: true},
+			{Component: "user-service", Level: "info"},
+		},
+	}, document)
+}
+
+func TestHandler_Change(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		body     string
+		expected func(levels *slf4go_api.ComponentLevels)
+	}{
+		{
+			name: "component",
+			body: `{"component": "payment-gateway", "level": "trace"}`,
+			expected: func(levels *slf4go_api.ComponentLevels) {
+				assert.Equal(t, slf4go_api.Trace, levels.Level("payment-gateway"))
+				assert.Equal(t, slf4go_api.Info, levels.DefaultLevel())
+			},
+		},
+		{
+			name: "default-level",
+			body: `{"level": "DEBUG"}`,
+			expected: func(levels *slf4go_api.ComponentLevels) {
+				assert.Equal(t, slf4go_api.Debug, levels.DefaultLevel())
+			},
+		},
+		{
+			name: "warn-alias",
+			body: `{"component": "billing", "level": "warn"}`,
+			expected: func(levels *slf4go_api.ComponentLevels) {
+				assert.Equal(t, slf4go_api.Warn, levels.Level("billing.invoice"))
+			},
+		},
+	}
+
+	for _, method := range []string{http.MethodPut, http.MethodPost} {
+		for _, scenario := range scenarios {
+			t.Run(method+"/"+scenario.name, func(t *testing.T) {
+				levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+				handler, timers := newTestHandler(levels)
+
+				code, _ := serve(t, handler, method, scenario.body)
+
+				assert.Equal(t, http.StatusOK, code)
+				scenario.expected(levels)
+				assert.Empty(t, timers.expire)
+			})
+		}
+	}
+}
+
+func TestNewHandlerFunc(t *testing.T) {
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	handler := NewHandlerFunc(func() *slf4go_api.ComponentLevels { return levels })
+
+	code, _ := serve(t, handler, http.MethodPut, `{"component": "payment-gateway", "level": "trace"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, slf4go_api.Trace, levels.Level("payment-gateway"))
+
+	previous := levels
+	levels = slf4go_api.NewComponentLevels(slf4go_api.Warn)
+	code, document := serve(t, handler, http.MethodPut, `{"component": "billing", "level": "debug"}`)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "warning", document.DefaultLevel)
+	assert.Equal(t, slf4go_api.Debug, levels.Level("billing"))
+	assert.Equal(t, slf4go_api.Info, previous.Level("billing"))
+}
+
+func TestHandler_ChangeBackendLevel(t *testing.T) {
+	logrusLogger := logrus.New()
+	logrusLogger.SetLevel(logrus.InfoLevel)
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Trace)
+	handler, timers := newTestHandler(levels, WithBackendLevel(slf4go_logrus_provider.New(logrusLogger)))
+
+	code, document := serve(t, handler, http.MethodPut, `{"backend": true, "level": "trace", "ttl": "10m"}`)
+
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "trace", document.BackendLevel)
+	assert.NotNil(t, document.BackendRevertAt)
+	assert.Nil(t, document.RevertAt)
+	assert.Equal(t, logrus.TraceLevel, logrusLogger.GetLevel())
+	require.Len(t, timers.expire, 1)
+	assert.Equal(t, 10*time.Minute, timers.durations[0])
+
+	timers.expire[0]()
+	assert.Equal(t, logrus.InfoLevel, logrusLogger.GetLevel())
+	_, document = serve(t, handler, http.MethodGet, "")
+	assert.Nil(t, document.BackendRevertAt)
+}
+
+func TestHandler_TTL(t *testing.T) {
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	levels.SetLevel("billing", slf4go_api.Warn)
+	handler, timers := newTestHandler(levels)
+
+	t.Run("reverts-to-no-override", func(t *testing.T) {
+		code, document := serve(t, handler, http.MethodPut, `{"component": "payment-gateway", "level": "trace", "ttl": "15m"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, slf4go_api.Trace, levels.Level("payment-gateway"))
+		assert.NotNil(t, componentLevel(document, "payment-gateway").RevertAt)
+
+		timers.expire[0]()
+		assert.NotContains(t, levels.Overrides(), slf4go_api.AppComponent("payment-gateway"))
+		_, document = serve(t, handler, http.MethodGet, "")
+		assert.Equal(t, &ComponentLevel{Component: "payment-gateway", Level: "info"}, componentLevel(document, "payment-gateway"))
+	})
+
+	t.Run("reverts-to-previous-override", func(t *testing.T) {
+		serve(t, handler, http.MethodPut, `{"component": "billing", "level": "trace", "ttl": "1m"}`)
+		serve(t, handler, http.MethodPut, `{"component": "billing", "level": "debug", "ttl": "1m"}`)
+		assert.Equal(t, slf4go_api.Debug, levels.Level("billing"))
+
+		// the replaced revert must not apply, the pending one restores the level before the first change
+		timers.expire[1]()
+		assert.Equal(t, slf4go_api.Debug, levels.Level("billing"))
+		timers.expire[2]()
+		assert.Equal(t, slf4go_api.Warn, levels.Level("billing"))
+	})
+
+	t.Run("permanent-change-cancels-revert", func(t *testing.T) {
+		serve(t, handler, http.MethodPut, `{"level": "trace", "ttl": "1m"}`)
+		serve(t, handler, http.MethodPut, `{"level": "error"}`)
+
+		timers.expire[3]()
+		assert.Equal(t, slf4go_api.Error, levels.DefaultLevel())
+	})
+}
+
+func TestHandler_Errors(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		method   string
+		body     string
+		expected int
+	}{
+		{"invalid-json", http.MethodPut, `{"level":`, http.StatusBadRequest},
+		{"unknown-level", http.MethodPut, `{"level": "verbose"}`, http.StatusBadRequest},
+		{"invalid-ttl", http.MethodPut, `{"level": "trace", "ttl": "soon"}`, http.StatusBadRequest},
+		{"negative-ttl", http.MethodPut, `{"level": "trace", "ttl": "-1m"}`, http.StatusBadRequest},
+		{"no-backend", http.MethodPut, `{"backend": true, "level": "trace"}`, http.StatusBadRequest},
+		{"too-large", http.MethodPut, `{"level": "trace", "component": "` + strings.Repeat("a", MaxRequestBytes) + `"}`, http.StatusRequestEntityTooLarge},
+		{"method-not-allowed", http.MethodDelete, "", http.StatusMethodNotAllowed},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+			handler, _ := newTestHandler(levels)
+
+			code, _ := serve(t, handler, scenario.method, scenario.body)
+
+			assert.Equal(t, scenario.expected, code)
+			assert.Equal(t, slf4go_api.Info, levels.DefaultLevel())
+			assert.Empty(t, levels.Overrides())
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package slf4go_api
 
+import "context"
+
 //go:generate go run github.com/golang/mock/mockgen@latest -package=test_mocks -destination=./test_mocks/slf4go_mock_logger.go github.com/MariusSchmidt/slf4go/slf4go_api Slf4GoLogger
 
 // AllLevels contains all available log levels in descending order of severity.
@@ -15,8 +17,9 @@ var AllLevels = []LogLevel{
 
 // Constants for the different logging levels, sorted by descending severity.
 const (
-	// Fatal logs the message and terminates the program with Exit(1),
-	// even if the logging level is set to Panic.
+	// Fatal logs the message, even if the logging level is set to Panic, and terminates the program via Exit.
+	// Exit runs the shutdown hooks registered via RegisterShutdownHook first, then calls the exit function
+	// set via SetExitFunc, os.Exit by default, with the exit code set via SetExitCode, 1 by default.
 	Fatal LogLevel = iota
 
 	// Panic is the highest severity level. The logger logs the message and then calls panic().
@@ -42,8 +45,9 @@ const (
 // LogLevel defines the different logging levels as uint32.
 type LogLevel uint32
 
-// Stringer converts a log level to its string representation.
-func (level LogLevel) Stringer() string {
+// String converts a log level to its string representation, e.g. "warning" for Warn.
+// Invalid levels yield "unknown". ParseLevel converts the representation back.
+func (level LogLevel) String() string {
 	switch level {
 	case Trace:
 		return "trace"
@@ -64,6 +68,13 @@ func (level LogLevel) Stringer() string {
 	}
 }
 
+// Stringer converts a log level to its string representation.
+//
+// Deprecated: Use String, which makes LogLevel a fmt.Stringer.
+func (level LogLevel) Stringer() string {
+	return level.String()
+}
+
 // DefaultAppComponentTag defines the default key for component tags in log entries
 const DefaultAppComponentTag string = "appComponent"
 
@@ -81,9 +92,41 @@ type Slf4GoLogger interface {
 	WithAppComponentLabel(appComponentLabel string) Slf4GoLogger
 
 	// WithStaticTags creates a new Slf4GoLogger instance with predefined tags
-	// that will be added to every log entry.
+	// that will be added to every log entry. The tags replace the static tags of the original logger,
+	// use AddStaticTags to keep them.
 	WithStaticTags(tags LogTags) Slf4GoLogger
 
+	// WithError creates a new Slf4GoLogger instance that attaches the given error to every log entry.
+	// Providers log the error message under ErrorTag and the chain of wrapped errors under ErrorChainTag
+	// (see ErrorTags). The returned logger inherits all other properties from the original logger.
+	WithError(err error) Slf4GoLogger
+
+	// WithCallerReporting creates a new Slf4GoLogger instance that adds the call site of every entry under
+	// CallerTag and the calling function under FunctionTag (see CallerTags), or stops doing so if disabled.
+	// The call site is the caller of the logging method, regardless of the entry point that was used.
+	// The returned logger inherits all other properties from the original logger.
+	WithCallerReporting(enabled bool) Slf4GoLogger
+
+	// WithStackTrace creates a new Slf4GoLogger instance that adds the stack trace of the call site under
+	// StackTraceTag to all entries at least as severe as the given threshold, e.g. WithStackTrace(Error)
+	// for Error, Panic and Fatal entries. WithStackTrace(NoStackTrace) disables stack trace capture.
+	// The returned logger inherits all other properties from the original logger.
+	WithStackTrace(threshold LogLevel) Slf4GoLogger
+
+	// WithComponentLevels creates a new Slf4GoLogger instance that only emits entries enabled for its component
+	// by the given ComponentLevels, in addition to the level of the backend. Levels changed at runtime apply
+	// immediately. Passing nil removes the restriction.
+	// The returned logger inherits all other properties from the original logger.
+	WithComponentLevels(levels *ComponentLevels) Slf4GoLogger
+
+	// IsEnabled reports whether log entries of the given level are emitted. It allows callers to skip
+	// building expensive tags or arguments for entries that would be discarded anyway.
+	IsEnabled(level LogLevel) bool
+	// IsDebugEnabled is a shorthand for IsEnabled(Debug).
+	IsDebugEnabled() bool
+	// IsTraceEnabled is a shorthand for IsEnabled(Trace).
+	IsTraceEnabled() bool
+
 	// Logf logs a message with the specified level and formatted text.
 	Logf(level LogLevel, msgTemplate string, args ...interface{})
 
@@ -123,6 +166,64 @@ type Slf4GoLogger interface {
 	DebugWithTagsf(tags LogTags, msgTemplate string, args ...interface{})
 	// TraceWithTagsf logs a trace message with additional tags using the specified format and arguments
 	TraceWithTagsf(tags LogTags, msgTemplate string, args ...interface{})
+
+	// LogErrf logs a message with the specified level, an attached error, and formatted text.
+	// It is a shorthand for WithError(err).Logf(level, msgTemplate, args...).
+	LogErrf(level LogLevel, err error, msgTemplate string, args ...interface{})
+
+	// FatalErrf logs critical errors with an attached error, then terminates the program.
+	FatalErrf(err error, msgTemplate string, args ...interface{})
+	// PanicErrf logs severe errors with an attached error, then panics.
+	PanicErrf(err error, msgTemplate string, args ...interface{})
+	// ErrorErrf logs errors with an attached error.
+	ErrorErrf(err error, msgTemplate string, args ...interface{})
+	// WarnErrf logs warnings with an attached error.
+	WarnErrf(err error, msgTemplate string, args ...interface{})
+	// WarningErrf is an alias for WarnErrf that logs warnings with an attached error.
+	WarningErrf(err error, msgTemplate string, args ...interface{})
+
+	// LogCtxf logs a message with the specified level and formatted text.
+	// Tags extracted from ctx via ExtractContextTags are added to the log entry.
+	LogCtxf(ctx context.Context, level LogLevel, msgTemplate string, args ...interface{})
+
+	// LogWithTagsCtxf logs a message with the specified level, additional tags, and formatted text.
+	// Tags extracted from ctx via ExtractContextTags are added to the log entry. On conflicting keys
+	// the additional tags take precedence over the context tags, which take precedence over static tags.
+	LogWithTagsCtxf(ctx context.Context, level LogLevel, tags LogTags, msgTemplate string, args ...interface{})
+
+	// FatalCtxf logs critical errors enriched with context tags, then terminates the program.
+	FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// PanicCtxf logs severe errors enriched with context tags, then panics.
+	PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// ErrorCtxf logs errors enriched with context tags.
+	ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// WarnCtxf logs warnings enriched with context tags.
+	WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// WarningCtxf is an alias for WarnCtxf that logs warnings enriched with context tags.
+	WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// InfoCtxf logs general information enriched with context tags.
+	InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// DebugCtxf logs debug information enriched with context tags.
+	DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+	// TraceCtxf logs very detailed debug information enriched with context tags.
+	TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{})
+
+	// FatalWithTagsCtxf logs a fatal message with additional tags and context tags, then terminates
+	FatalWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// PanicWithTagsCtxf logs a panic message with additional tags and context tags, then panics
+	PanicWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// ErrorWithTagsCtxf logs an error message with additional tags and context tags
+	ErrorWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// WarnWithTagsCtxf logs a warning message with additional tags and context tags
+	WarnWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// WarningWithTagsCtxf is an alias for WarnWithTagsCtxf that logs a warning message with additional tags and context tags
+	WarningWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// InfoWithTagsCtxf logs an info message with additional tags and context tags
+	InfoWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// DebugWithTagsCtxf logs a debug message with additional tags and context tags
+	DebugWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
+	// TraceWithTagsCtxf logs a trace message with additional tags and context tags
+	TraceWithTagsCtxf(ctx context.Context, tags LogTags, msgTemplate string, args ...interface{})
 }
 
 // AppComponent represents a significant component of the application to be mentioned in logs.
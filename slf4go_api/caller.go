@@ -0,0 +1,86 @@
+package slf4go_api
+
+import (
+	"net/url"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Keys under which providers log the call site of entries if caller reporting is enabled.
+const (
+	// CallerTag is the key of the call site in the form "file:line".
+	CallerTag string = "caller"
+	// FunctionTag is the key of the fully qualified name of the calling function.
+	FunctionTag string = "function"
+)
+
+// maxStackDepth limits the number of frames inspected to find the call site or to capture a stack trace.
+const maxStackDepth = 64
+
+var (
+	loggingPackages      = map[string]bool{reflect.TypeOf(LogLevel(0)).PkgPath(): true}
+	loggingPackagesMutex sync.RWMutex
+)
+
+// RegisterLoggingPackage marks all frames of the package with the given import path as internal to the logging
+// machinery. Such frames are skipped when determining the call site of an entry, no matter how many layers of
+// delegation an entry point goes through. Providers register their own package in an init function; decorators
+// wrapping a Slf4GoLogger should do the same.
+func RegisterLoggingPackage(pkgPath string) {
+	loggingPackagesMutex.Lock()
+	defer loggingPackagesMutex.Unlock()
+	loggingPackages[pkgPath] = true
+}
+
+// CallerTags returns the tags describing the first frame of the current call stack outside of all registered
+// logging packages: the call site under CallerTag and the calling function under FunctionTag.
+// Providers call it for every emitted entry if caller reporting is enabled.
+func CallerTags() LogTags {
+	frame, ok := callerFrame()
+	if !ok {
+		return LogTags{}
+	}
+	return LogTags{
+		CallerTag:   frame.File + ":" + strconv.Itoa(frame.Line),
+		FunctionTag: frame.Function,
+	}
+}
+
+// callerFrame returns the first frame of the current call stack outside of all registered logging packages.
+func callerFrame() (runtime.Frame, bool) {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers and callerFrame
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !isLoggingFrame(frame) {
+			return frame, true
+		}
+		if !more {
+			return runtime.Frame{}, false
+		}
+	}
+}
+
+func isLoggingFrame(frame runtime.Frame) bool {
+	loggingPackagesMutex.RLock()
+	defer loggingPackagesMutex.RUnlock()
+	return loggingPackages[packagePath(frame.Function)]
+}
+
+// packagePath extracts the import path of the package from a fully qualified function name like
+// "github.com/org/repo/pkg.(*Type).Method.func1". Dots in the last element of the import path are escaped in
+// function names, e.g. "gopkg.in/yaml%2ev3.Marshal", so the first dot after the last slash ends the path.
+func packagePath(function string) string {
+	lastSlash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[lastSlash+1:], "."); dot >= 0 {
+		function = function[:lastSlash+1+dot]
+	}
+	if path, err := url.PathUnescape(function); err == nil {
+		return path
+	}
+	return function
+}
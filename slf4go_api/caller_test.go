@@ -0,0 +1,40 @@
+package slf4go_api_test
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+)
+
+// The caller tests live in an external test package, as frames of slf4go_api itself are skipped
+// when determining the call site.
+
+func TestCallerTags(t *testing.T) {
+	tags := slf4go_api.CallerTags()
+	_, file, line, _ := runtime.Caller(0)
+	assert.Equal(t, slf4go_api.LogTags{
+		slf4go_api.CallerTag:   file + ":" + strconv.Itoa(line-1),
+		slf4go_api.FunctionTag: "github.com/MariusSchmidt/slf4go/slf4go_api_test.TestCallerTags",
+	}, tags)
+}
+
+func TestPackagePath(t *testing.T) {
+	scenarios := []struct {
+		function string
+		expected string
+	}{
+		{"github.com/org/repo/pkg.(*Type).Method.func1", "github.com/org/repo/pkg"},
+		{"github.com/org/repo/pkg.Function", "github.com/org/repo/pkg"},
+		{"gopkg.in/yaml%2ev3.(*encoder).marshal", "gopkg.in/yaml.v3"},
+		{"main.main", "main"},
+		{"runtime.goexit", "runtime"},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.function, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4go_api.PackagePath(scenario.function))
+		})
+	}
+}
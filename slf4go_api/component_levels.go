@@ -0,0 +1,152 @@
+package slf4go_api
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ComponentLevels holds the log levels of AppComponents: a default level and per-component overrides.
+// Loggers created via WithComponentLevels consult it before emitting an entry, in addition to the level of
+// their backend. To raise the verbosity of a single component at runtime, configure the backend with the most
+// verbose level needed (typically Trace) and control the effective levels via ComponentLevels.
+//
+// Component names are hierarchical like the names of GetLogger loggers: an override for "billing" also applies
+// to "billing.invoice", unless "billing.invoice" has an override of its own.
+// ComponentLevels is safe for concurrent use.
+type ComponentLevels struct {
+	mutex        sync.RWMutex
+	defaultLevel LogLevel
+	levels       map[AppComponent]LogLevel
+	known        map[AppComponent]bool
+}
+
+// NewComponentLevels creates ComponentLevels without overrides, applying the given level to all components.
+func NewComponentLevels(defaultLevel LogLevel) *ComponentLevels {
+	return &ComponentLevels{
+		defaultLevel: defaultLevel,
+		levels:       make(map[AppComponent]LogLevel),
+		known:        make(map[AppComponent]bool),
+	}
+}
+
+// DefaultLevel returns the level of all components without override.
+func (c *ComponentLevels) DefaultLevel() LogLevel {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.defaultLevel
+}
+
+// SetDefaultLevel changes the level of all components without override.
+func (c *ComponentLevels) SetDefaultLevel(level LogLevel) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.defaultLevel = level
+}
+
+// SetLevel overrides the level of the given component and its descendants.
+func (c *ComponentLevels) SetLevel(component AppComponent, level LogLevel) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.levels[component] = level
+	c.known[component] = true
+}
+
+// ResetLevel removes the override of the given component, which falls back to the level of its closest
+// ancestor with override or to the default level.
+func (c *ComponentLevels) ResetLevel(component AppComponent) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.levels, component)
+}
+
+// Replace replaces the default level and all overrides at once, so that concurrent loggers never observe a mix of
+// the previous and the new levels.
+func (c *ComponentLevels) Replace(defaultLevel LogLevel, overrides map[AppComponent]LogLevel) {
+	levels := make(map[AppComponent]LogLevel, len(overrides))
+	for component, level := range overrides {
+		levels[component] = level
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.defaultLevel = defaultLevel
+	c.levels = levels
+	for component := range levels {
+		c.known[component] = true
+	}
+}
+
+// Overrides returns a copy of all per-component overrides.
+func (c *ComponentLevels) Overrides() map[AppComponent]LogLevel {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	overrides := make(map[AppComponent]LogLevel, len(c.levels))
+	for component, level := range c.levels {
+		overrides[component] = level
+	}
+	return overrides
+}
+
+// Level returns the effective level of the given component.
+func (c *ComponentLevels) Level(component AppComponent) LogLevel {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.level(component)
+}
+
+// level returns the effective level of the given component. The caller has to hold the mutex.
+func (c *ComponentLevels) level(component AppComponent) LogLevel {
+	for name := string(component); ; {
+		if level, ok := c.levels[AppComponent(name)]; ok {
+			return level
+		}
+		i := strings.LastIndex(name, ".")
+		if i < 0 {
+			return c.defaultLevel
+		}
+		name = name[:i]
+	}
+}
+
+// Components returns all components that have an override or a logger consulting these ComponentLevels,
+// sorted by name, see Register.
+func (c *ComponentLevels) Components() []AppComponent {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	components := make([]AppComponent, 0, len(c.known))
+	for component := range c.known {
+		components = append(components, component)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i] < components[j]
+	})
+	return components
+}
+
+// Register records the given component as known, so that Components lists it. Providers call it when a logger for
+// the component is created, not per entry, so the known components stay bounded by the loggers of the application.
+// A nil *ComponentLevels and the empty component are ignored.
+func (c *ComponentLevels) Register(component AppComponent) {
+	if c == nil || len(component) == 0 {
+		return
+	}
+	c.mutex.RLock()
+	known := c.known[component]
+	c.mutex.RUnlock()
+	if !known {
+		c.mutex.Lock()
+		c.known[component] = true
+		c.mutex.Unlock()
+	}
+}
+
+// IsEnabled reports whether entries of the given level are emitted for the given component. Providers call it
+// before emitting an entry. A nil *ComponentLevels enables all levels, leaving the decision to the backend.
+func (c *ComponentLevels) IsEnabled(component AppComponent, level LogLevel) bool {
+	if c == nil {
+		return true
+	}
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return level <= c.level(component)
+}
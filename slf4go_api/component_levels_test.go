@@ -0,0 +1,102 @@
+package slf4go_api
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentLevels(t *testing.T) {
+	levels := NewComponentLevels(Info)
+	levels.SetLevel("payment-gateway", Trace)
+	levels.SetLevel("billing", Warn)
+	levels.SetLevel("billing.invoice", Debug)
+
+	scenarios := []struct {
+		name      string
+		component AppComponent
+		expected  LogLevel
+	}{
+		{"default", "user-service", Info},
+		{"no-component", "", Info},
+		{"override", "payment-gateway", Trace},
+		{"override-of-parent", "billing.reminder", Warn},
+		{"override-of-grandparent", "billing.reminder.mail", Warn},
+		{"override-of-child", "billing.invoice.pdf", Debug},
+		{"prefix-is-no-parent", "billingreport", Info},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, levels.Level(scenario.component))
+		})
+	}
+
+	t.Run("is-enabled", func(t *testing.T) {
+		assert.True(t, levels.IsEnabled("payment-gateway", Trace))
+		assert.False(t, levels.IsEnabled("user-service", Debug))
+		assert.True(t, levels.IsEnabled("user-service", Info))
+		assert.True(t, levels.IsEnabled("billing", Fatal))
+	})
+
+	t.Run("nil-enables-all-levels", func(t *testing.T) {
+		var nilLevels *ComponentLevels
+		assert.True(t, nilLevels.IsEnabled("payment-gateway", Trace))
+	})
+
+	t.Run("reset-and-default", func(t *testing.T) {
+		levels.ResetLevel("billing.invoice")
+		levels.SetDefaultLevel(Error)
+		assert.Equal(t, Warn, levels.Level("billing.invoice.pdf"))
+		assert.Equal(t, Error, levels.Level("user-service"))
+		assert.Equal(t, Error, levels.DefaultLevel())
+		assert.Equal(t, map[AppComponent]LogLevel{"payment-gateway": Trace, "billing": Warn}, levels.Overrides())
+	})
+
+	t.Run("components", func(t *testing.T) {
+		assert.Equal(t, []AppComponent{"billing", "billing.invoice", "payment-gateway"}, levels.Components())
+	})
+
+	t.Run("register", func(t *testing.T) {
+		levels.Register("user-service")
+		levels.Register("")
+		var nilLevels *ComponentLevels
+		nilLevels.Register("user-service")
+		assert.Equal(t, []AppComponent{"billing", "billing.invoice", "payment-gateway", "user-service"}, levels.Components())
+	})
+
+	t.Run("replace", func(t *testing.T) {
+		overrides := map[AppComponent]LogLevel{"billing.invoice": Trace, "shipping": Debug}
+		levels.Replace(Warn, overrides)
+		overrides["billing.invoice"] = Fatal
+		assert.Equal(t, Warn, levels.DefaultLevel())
+		assert.Equal(t, Warn, levels.Level("payment-gateway"))
+		assert.Equal(t, Trace, levels.Level("billing.invoice.pdf"))
+		assert.Equal(t, map[AppComponent]LogLevel{"billing.invoice": Trace, "shipping": Debug}, levels.Overrides())
+		assert.Contains(t, levels.Components(), AppComponent("shipping"))
+	})
+}
+
+func TestComponentLevels_Concurrency(t *testing.T) {
+	levels := NewComponentLevels(Info)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				levels.SetLevel("payment-gateway", AllLevels[j%len(AllLevels)])
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				levels.Register("payment-gateway.card")
+				levels.IsEnabled("payment-gateway.card", Debug)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Contains(t, levels.Components(), AppComponent("payment-gateway.card"))
+}
@@ -0,0 +1,98 @@
+package slf4go_api
+
+import (
+	"context"
+	"sync"
+)
+
+// ContextTagExtractor extracts tags from a context.Context that should be added to every log entry
+// written through one of the context-aware methods of Slf4GoLogger (e.g. InfoCtxf).
+// Typical extractors read request-scoped data such as trace or request IDs from the context.
+type ContextTagExtractor func(ctx context.Context) LogTags
+
+// Extractors are kept in order of registration along with an id, so that they can be unregistered.
+var (
+	contextTagExtractorsMutex sync.RWMutex
+	contextTagExtractors      []registeredExtractor
+	nextExtractorID           uint64
+)
+
+type registeredExtractor struct {
+	id        uint64
+	extractor ContextTagExtractor
+}
+
+type contextTagsKey struct{}
+
+// RegisterContextTagExtractor registers an extractor that is consulted by all providers whenever a
+// context-aware logging method is called. Extractors are applied in registration order, so tags of
+// later extractors take precedence over tags of earlier ones. The returned function unregisters the extractor.
+func RegisterContextTagExtractor(extractor ContextTagExtractor) (unregister func()) {
+	if extractor == nil {
+		return func() {}
+	}
+	contextTagExtractorsMutex.Lock()
+	defer contextTagExtractorsMutex.Unlock()
+	nextExtractorID++
+	id := nextExtractorID
+	contextTagExtractors = append(contextTagExtractors, registeredExtractor{id: id, extractor: extractor})
+	return func() {
+		contextTagExtractorsMutex.Lock()
+		defer contextTagExtractorsMutex.Unlock()
+		for i, registered := range contextTagExtractors {
+			if registered.id == id {
+				contextTagExtractors = append(contextTagExtractors[:i:i], contextTagExtractors[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// ContextWithTags returns a copy of ctx that carries the given tags in addition to the tags already
+// attached to ctx. Tags attached this way are always extracted, without registering an extractor.
+func ContextWithTags(ctx context.Context, tags LogTags) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	merged := make(LogTags, len(tags))
+	for k, v := range TagsFromContext(ctx) {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return context.WithValue(ctx, contextTagsKey{}, merged)
+}
+
+// TagsFromContext returns the tags attached to ctx via ContextWithTags.
+// The returned map must not be modified.
+func TagsFromContext(ctx context.Context) LogTags {
+	if ctx == nil {
+		return nil
+	}
+	tags, _ := ctx.Value(contextTagsKey{}).(LogTags)
+	return tags
+}
+
+// ExtractContextTags collects the tags attached to ctx via ContextWithTags and the tags returned by all
+// registered ContextTagExtractor functions. Providers call it for every context-aware logging method.
+// A nil context yields an empty result. The extractors are called without holding the registration lock, so they
+// may register and unregister extractors themselves.
+func ExtractContextTags(ctx context.Context) LogTags {
+	extracted := make(LogTags)
+	if ctx == nil {
+		return extracted
+	}
+	for k, v := range TagsFromContext(ctx) {
+		extracted[k] = v
+	}
+	contextTagExtractorsMutex.RLock()
+	extractors := contextTagExtractors
+	contextTagExtractorsMutex.RUnlock()
+	for _, registered := range extractors {
+		for k, v := range registered.extractor(ctx) {
+			extracted[k] = v
+		}
+	}
+	return extracted
+}
@@ -0,0 +1,82 @@
+package slf4go_api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type traceIDKey struct{}
+
+func TestExtractContextTags(t *testing.T) {
+	defer resetContextTagExtractors()
+
+	t.Run("nil-context", func(t *testing.T) {
+		//lint:ignore SA1012 a nil context must be tolerated by providers
+		assert.Equal(t, LogTags{}, ExtractContextTags(nil))
+	})
+
+	t.Run("background-context", func(t *testing.T) {
+		assert.Equal(t, LogTags{}, ExtractContextTags(context.Background()))
+	})
+
+	t.Run("context-with-tags", func(t *testing.T) {
+		ctx := ContextWithTags(context.Background(), LogTags{"key1": "val1"})
+		ctx = ContextWithTags(ctx, LogTags{"key2": "val2"})
+		assert.Equal(t, LogTags{"key1": "val1", "key2": "val2"}, ExtractContextTags(ctx))
+	})
+
+	t.Run("context-with-tags-does-not-modify-parent", func(t *testing.T) {
+		parent := ContextWithTags(context.Background(), LogTags{"key1": "val1"})
+		_ = ContextWithTags(parent, LogTags{"key1": "overridden"})
+		assert.Equal(t, LogTags{"key1": "val1"}, TagsFromContext(parent))
+	})
+
+	t.Run("registered-extractors", func(t *testing.T) {
+		RegisterContextTagExtractor(func(ctx context.Context) LogTags {
+			if traceID, ok := ctx.Value(traceIDKey{}).(string); ok {
+				return LogTags{"traceID": traceID}
+			}
+			return nil
+		})
+		RegisterContextTagExtractor(nil)
+		ctx := ContextWithTags(context.WithValue(context.Background(), traceIDKey{}, "trace-1"), LogTags{"key1": "val1"})
+		assert.Equal(t, LogTags{"key1": "val1", "traceID": "trace-1"}, ExtractContextTags(ctx))
+	})
+
+	t.Run("later-extractors-take-precedence", func(t *testing.T) {
+		RegisterContextTagExtractor(func(ctx context.Context) LogTags {
+			return LogTags{"key1": "from-extractor"}
+		})
+		ctx := ContextWithTags(context.Background(), LogTags{"key1": "val1"})
+		assert.Equal(t, LogTags{"key1": "from-extractor"}, ExtractContextTags(ctx))
+	})
+
+	t.Run("unregistered-extractors", func(t *testing.T) {
+		resetContextTagExtractors()
+		unregister := RegisterContextTagExtractor(func(ctx context.Context) LogTags {
+			return LogTags{"key1": "from-extractor"}
+		})
+		RegisterContextTagExtractor(nil)()
+		unregister()
+		unregister()
+		assert.Equal(t, LogTags{}, ExtractContextTags(context.Background()))
+	})
+
+	t.Run("extractors-registering-extractors", func(t *testing.T) {
+		resetContextTagExtractors()
+		RegisterContextTagExtractor(func(ctx context.Context) LogTags {
+			unregister := RegisterContextTagExtractor(func(ctx context.Context) LogTags { return nil })
+			unregister()
+			return LogTags{"key1": "from-extractor"}
+		})
+		assert.Equal(t, LogTags{"key1": "from-extractor"}, ExtractContextTags(context.Background()))
+	})
+}
+
+func resetContextTagExtractors() {
+	contextTagExtractorsMutex.Lock()
+	defer contextTagExtractorsMutex.Unlock()
+	contextTagExtractors = nil
+}
@@ -0,0 +1,47 @@
+package slf4go_api
+
+// Keys under which providers log errors attached via WithError or one of the Errf methods.
+const (
+	// ErrorTag is the key of the error message.
+	ErrorTag string = "error"
+	// ErrorChainTag is the key of the messages of the error and all errors it wraps.
+	ErrorChainTag string = "errorChain"
+)
+
+// ErrorChain returns the message of err followed by the messages of all errors wrapped by it. Wrapped errors
+// are discovered via Unwrap() error (e.g. fmt.Errorf with %w) and Unwrap() []error (e.g. errors.Join)
+// and are listed depth-first in the order they are wrapped. A nil error yields an empty chain.
+func ErrorChain(err error) []string {
+	var chain []string
+	var walk func(err error)
+	walk = func(err error) {
+		if err == nil {
+			return
+		}
+		chain = append(chain, err.Error())
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() error }:
+			walk(wrapper.Unwrap())
+		case interface{ Unwrap() []error }:
+			for _, wrapped := range wrapper.Unwrap() {
+				walk(wrapped)
+			}
+		}
+	}
+	walk(err)
+	return chain
+}
+
+// ErrorTags returns the tags providers add to a log entry for the given error: the error message under
+// ErrorTag and, if err wraps further errors, the ErrorChain under ErrorChainTag. A nil error yields no tags.
+func ErrorTags(err error) LogTags {
+	chain := ErrorChain(err)
+	switch len(chain) {
+	case 0:
+		return LogTags{}
+	case 1:
+		return LogTags{ErrorTag: chain[0]}
+	default:
+		return LogTags{ErrorTag: chain[0], ErrorChainTag: chain}
+	}
+}
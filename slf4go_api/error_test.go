@@ -0,0 +1,56 @@
+package slf4go_api
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorChain(t *testing.T) {
+	rootCause := errors.New("connection refused")
+	wrapped := fmt.Errorf("query failed: %w", rootCause)
+	joined := errors.Join(wrapped, errors.New("rollback failed"))
+
+	scenarios := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{"nil", nil, nil},
+		{"single", rootCause, []string{"connection refused"}},
+		{"wrapped", wrapped, []string{"query failed: connection refused", "connection refused"}},
+		{"joined", fmt.Errorf("transaction failed: %w", joined), []string{
+			"transaction failed: query failed: connection refused\nrollback failed",
+			"query failed: connection refused\nrollback failed",
+			"query failed: connection refused",
+			"connection refused",
+			"rollback failed",
+		}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, ErrorChain(scenario.err))
+		})
+	}
+}
+
+func TestErrorTags(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, LogTags{}, ErrorTags(nil))
+	})
+
+	t.Run("single", func(t *testing.T) {
+		assert.Equal(t, LogTags{ErrorTag: "connection refused"}, ErrorTags(errors.New("connection refused")))
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		err := fmt.Errorf("query failed: %w", errors.New("connection refused"))
+		assert.Equal(t, LogTags{
+			ErrorTag:      "query failed: connection refused",
+			ErrorChainTag: []string{"query failed: connection refused", "connection refused"},
+		}, ErrorTags(err))
+	})
+}
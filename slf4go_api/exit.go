@@ -0,0 +1,256 @@
+package slf4go_api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultExitCode is the exit code Fatal entries terminate the program with unless changed via SetExitCode.
+	DefaultExitCode = 1
+	// DefaultShutdownTimeout bounds the time the shutdown hooks may take before a Fatal entry terminates the
+	// program anyway, unless changed via SetShutdownTimeout.
+	DefaultShutdownTimeout = 5 * time.Second
+)
+
+// ShutdownHook flushes buffers, closes resources and the like before the program is terminated. Hooks are
+// expected to give up and return once ctx is done.
+type ShutdownHook func(ctx context.Context) error
+
+// FatalError is returned by CatchFatal if the function it ran logged a Fatal entry.
+type FatalError struct {
+	// Message is the message of the Fatal entry.
+	Message string
+	// Code is the exit code the program would have terminated with.
+	Code int
+}
+
+func (e *FatalError) Error() string {
+	return fmt.Sprintf("fatal: %s (exit code %d)", e.Message, e.Code)
+}
+
+// exitHandler holds the settings consulted by Exit. Hooks are kept in order of registration along with an id,
+// so that they can be unregistered.
+type exitHandler struct {
+	mutex    sync.Mutex
+	exitFunc func(code int)
+	code     int
+	timeout  time.Duration
+	hooks    []registeredHook
+	nextID   uint64
+}
+
+type registeredHook struct {
+	id   uint64
+	hook ShutdownHook
+}
+
+var exit = newExitHandler()
+
+func newExitHandler() *exitHandler {
+	return &exitHandler{
+		exitFunc: os.Exit,
+		code:     DefaultExitCode,
+		timeout:  DefaultShutdownTimeout,
+	}
+}
+
+// SetExitFunc replaces the function terminating the program after a Fatal entry, which is os.Exit by default.
+// A nil function restores os.Exit.
+func SetExitFunc(exitFunc func(code int)) {
+	if exitFunc == nil {
+		exitFunc = os.Exit
+	}
+	exit.mutex.Lock()
+	defer exit.mutex.Unlock()
+	exit.exitFunc = exitFunc
+}
+
+// SetExitCode sets the exit code Fatal entries terminate the program with.
+func SetExitCode(code int) {
+	exit.mutex.Lock()
+	defer exit.mutex.Unlock()
+	exit.code = code
+}
+
+// SetShutdownTimeout sets the time the shutdown hooks may take before a Fatal entry terminates the program
+// anyway. A timeout of zero or less restores DefaultShutdownTimeout.
+func SetShutdownTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	exit.mutex.Lock()
+	defer exit.mutex.Unlock()
+	exit.timeout = timeout
+}
+
+// RegisterShutdownHook registers a hook run by Shutdown and before a Fatal entry terminates the program.
+// Hooks run in reverse order of registration, like deferred calls, so that resources registered later,
+// e.g. a buffer writing to a file, are shut down before the resources they depend on. The returned function
+// unregisters the hook.
+func RegisterShutdownHook(hook ShutdownHook) (unregister func()) {
+	exit.mutex.Lock()
+	defer exit.mutex.Unlock()
+	exit.nextID++
+	id := exit.nextID
+	exit.hooks = append(exit.hooks, registeredHook{id: id, hook: hook})
+	return func() {
+		exit.mutex.Lock()
+		defer exit.mutex.Unlock()
+		for i, registered := range exit.hooks {
+			if registered.id == id {
+				exit.hooks = append(exit.hooks[:i:i], exit.hooks[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Shutdown runs all registered shutdown hooks in reverse order of registration and returns their errors joined.
+// Applications that do not terminate via a Fatal entry call Shutdown themselves before returning from main.
+func Shutdown(ctx context.Context) error {
+	return exit.shutdown(ctx)
+}
+
+func (h *exitHandler) shutdown(ctx context.Context) error {
+	h.mutex.Lock()
+	hooks := make([]ShutdownHook, 0, len(h.hooks))
+	for i := len(h.hooks) - 1; i >= 0; i-- {
+		hooks = append(hooks, h.hooks[i].hook)
+	}
+	h.mutex.Unlock()
+
+	var errs []error
+	for _, hook := range hooks {
+		if err := hook(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Exit terminates the program after a Fatal entry with the given message has been written. Providers call
+// Exit instead of terminating the program themselves, or ExitCtx if the entry was logged with a context.
+// Exit runs the shutdown hooks, giving up after the shutdown timeout, and calls the exit function with the
+// exit code. Hook errors are reported on stderr.
+func Exit(message string) {
+	ExitCtx(context.Background(), message)
+}
+
+// ExitCtx is like Exit for entries logged with the given context. If ctx carries the scope of CatchFatal,
+// ExitCtx reports the Fatal entry to CatchFatal and ends the calling goroutine instead of terminating the program.
+func ExitCtx(ctx context.Context, message string) {
+	ExitWithCtx(ctx, message, func(code int, exitFunc func(code int)) {
+		exitFunc(code)
+	})
+}
+
+// ExitWith is like Exit, but terminates the program via terminate after the shutdown hooks ran. terminate is
+// passed the exit code and the exit function set via SetExitFunc. Providers whose backend has exit handling of
+// its own call ExitWith to honour it.
+func ExitWith(message string, terminate func(code int, exitFunc func(code int))) {
+	ExitWithCtx(context.Background(), message, terminate)
+}
+
+// ExitWithCtx is like ExitWith for entries logged with the given context. Within the scope of CatchFatal,
+// terminate is not run.
+func ExitWithCtx(ctx context.Context, message string, terminate func(code int, exitFunc func(code int))) {
+	handler := exit
+	handler.mutex.Lock()
+	exitFunc, code, timeout := handler.exitFunc, handler.code, handler.timeout
+	handler.mutex.Unlock()
+
+	if scope := fatalScopeFrom(ctx); scope != nil {
+		scope.catch(&FatalError{Message: message, Code: code})
+		runtime.Goexit()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- handler.shutdown(ctx)
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to run shutdown hooks, %v\n", err)
+		}
+	case <-ctx.Done():
+		_, _ = fmt.Fprintf(os.Stderr, "Shutdown hooks did not finish within %s\n", timeout)
+	}
+	terminate(code, exitFunc)
+}
+
+// CatchFatal runs fn with a logger derived from the given logger whose Fatal entries do not terminate the program.
+// Instead, CatchFatal returns a *FatalError for the first of them. The Fatal entries are written as usual, but
+// neither the shutdown hooks nor the exit function are run and the goroutine logging the entry ends (see
+// runtime.Goexit) like after testing.T.FailNow. Meant for tests, CatchFatal runs fn on a goroutine of its own and
+// waits for it to end. Panics of fn other than the goroutine exit are passed on.
+//
+// The scope of CatchFatal is passed on with every entry of the derived logger and of the loggers derived from
+// it as a context value (see ExitCtx), so their Fatal entries are caught on any goroutine, e.g. on goroutines
+// started by fn. Fatal entries of other loggers terminate the program as usual.
+func CatchFatal(logger Slf4GoLogger, fn func(logger Slf4GoLogger)) error {
+	scope := &fatalScope{}
+	var recovered interface{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			recovered = recover()
+		}()
+		fn(&fatalCatchingLogger{target: logger, scope: scope})
+	}()
+	<-done
+	if recovered != nil {
+		panic(recovered)
+	}
+	return scope.caught()
+}
+
+// fatalScope records the first Fatal entry logged within the scope of CatchFatal.
+type fatalScope struct {
+	mutex sync.Mutex
+	err   *FatalError
+}
+
+type fatalScopeKey struct{}
+
+func (s *fatalScope) catch(err *FatalError) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+func (s *fatalScope) caught() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.err == nil {
+		return nil
+	}
+	return s.err
+}
+
+// withFatalScope returns a context carrying the given scope, derived from ctx or, if nil, from the background.
+func withFatalScope(ctx context.Context, scope *fatalScope) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, fatalScopeKey{}, scope)
+}
+
+func fatalScopeFrom(ctx context.Context) *fatalScope {
+	if ctx == nil {
+		return nil
+	}
+	scope, _ := ctx.Value(fatalScopeKey{}).(*fatalScope)
+	return scope
+}
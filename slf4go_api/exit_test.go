@@ -0,0 +1,186 @@
+package slf4go_api_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExit(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	var calls []string
+	exitCode := -1
+	slf4go_api.SetExitFunc(func(code int) {
+		calls = append(calls, "exit")
+		exitCode = code
+	})
+	slf4go_api.SetExitCode(3)
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error {
+		calls = append(calls, "close file")
+		return nil
+	})
+	unregister := slf4go_api.RegisterShutdownHook(func(ctx context.Context) error {
+		calls = append(calls, "unregistered")
+		return nil
+	})
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error {
+		calls = append(calls, "flush buffer")
+		return errors.New("buffer closed")
+	})
+	unregister()
+
+	slf4go_api.Exit("disk full")
+
+	assert.Equal(t, []string{"flush buffer", "close file", "exit"}, calls)
+	assert.Equal(t, 3, exitCode)
+}
+
+func TestExit_ShutdownTimeout(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	exited := false
+	slf4go_api.SetExitFunc(func(int) { exited = true })
+	slf4go_api.SetShutdownTimeout(10 * time.Millisecond)
+	release := make(chan struct{})
+	defer close(release)
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error {
+		<-release
+		return nil
+	})
+
+	start := time.Now()
+	slf4go_api.Exit("disk full")
+
+	assert.True(t, exited)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestShutdown(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	errFlush, errClose := errors.New("flush failed"), errors.New("close failed")
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error { return errClose })
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error { return errFlush })
+
+	err := slf4go_api.Shutdown(context.Background())
+
+	assert.ErrorIs(t, err, errFlush)
+	assert.ErrorIs(t, err, errClose)
+}
+
+func TestCatchFatal(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	slf4go_api.SetExitFunc(func(int) { t.Fatal("exit function must not be called within CatchFatal") })
+	slf4go_api.RegisterShutdownHook(func(ctx context.Context) error {
+		t.Fatal("shutdown hooks must not be run within CatchFatal")
+		return nil
+	})
+	logger := slf4go_api.GetLogger("billing")
+
+	t.Run("fatal", func(t *testing.T) {
+		continued := false
+		err := slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+			logger.Fatalf("disk %s", "full")
+			continued = true
+		})
+
+		var fatalErr *slf4go_api.FatalError
+		require.ErrorAs(t, err, &fatalErr)
+		assert.Equal(t, &slf4go_api.FatalError{Message: "disk full", Code: slf4go_api.DefaultExitCode}, fatalErr)
+		assert.Equal(t, "fatal: disk full (exit code 1)", err.Error())
+		assert.False(t, continued)
+	})
+
+	t.Run("no-fatal", func(t *testing.T) {
+		assert.NoError(t, slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+			logger.Errorf("card declined")
+		}))
+	})
+
+	t.Run("other-panics-are-passed-on", func(t *testing.T) {
+		assert.PanicsWithValue(t, "inconsistent state", func() {
+			_ = slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+				logger.Panicf("inconsistent state")
+			})
+		})
+	})
+}
+
+func TestCatchFatal_OtherGoroutines(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	exited := make(chan int, 1)
+	slf4go_api.SetExitFunc(func(code int) { exited <- code })
+
+	t.Run("derived-loggers-are-caught", func(t *testing.T) {
+		err := slf4go_api.CatchFatal(slf4go_api.GetLogger("scheduler"), func(logger slf4go_api.Slf4GoLogger) {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				logger.ForComponent("worker").WithStaticTags(slf4go_api.LogTags{"queue": "billing"}).Fatalf("queue lost")
+			}()
+			<-done
+		})
+
+		assert.Equal(t, &slf4go_api.FatalError{Message: "queue lost", Code: slf4go_api.DefaultExitCode}, err)
+		assert.Empty(t, exited)
+	})
+
+	t.Run("other-loggers-exit", func(t *testing.T) {
+		err := slf4go_api.CatchFatal(slf4go_api.GetLogger("scheduler"), func(slf4go_api.Slf4GoLogger) {
+			slf4go_api.GetLogger("scheduler").Fatalf("queue lost")
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, slf4go_api.DefaultExitCode, <-exited)
+	})
+}
+
+// contextCapturingLogger keeps the context of the last entry logged via LogCtxf.
+type contextCapturingLogger struct {
+	slf4go_api.Slf4GoLogger
+	ctx context.Context
+}
+
+func (l *contextCapturingLogger) LogCtxf(ctx context.Context, _ slf4go_api.LogLevel, _ string, _ ...interface{}) {
+	l.ctx = ctx
+}
+
+func TestExitCtx(t *testing.T) {
+	slf4go_api.ResetExitHandler()
+	defer slf4go_api.ResetExitHandler()
+	exited := make(chan int, 1)
+	slf4go_api.SetExitFunc(func(code int) { exited <- code })
+
+	t.Run("without-scope", func(t *testing.T) {
+		slf4go_api.ExitCtx(context.Background(), "disk full")
+		assert.Equal(t, slf4go_api.DefaultExitCode, <-exited)
+	})
+
+	t.Run("within-scope", func(t *testing.T) {
+		capturing := &contextCapturingLogger{Slf4GoLogger: slf4go_api.GetLogger("billing")}
+		_ = slf4go_api.CatchFatal(capturing, func(logger slf4go_api.Slf4GoLogger) {
+			logger.Infof("started")
+		})
+		require.NotNil(t, capturing.ctx)
+
+		continued := false
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			slf4go_api.ExitCtx(capturing.ctx, "disk full")
+			continued = true
+		}()
+		<-done
+
+		assert.False(t, continued, "ExitCtx should have ended the goroutine")
+		assert.Empty(t, exited)
+	})
+}
@@ -0,0 +1,14 @@
+package slf4go_api
+
+// PackagePath exposes packagePath to the external caller tests.
+var PackagePath = packagePath
+
+// ResetLoggerFactory discards the registered provider, the logger configurations and all handed-out loggers.
+func ResetLoggerFactory() {
+	factory = newLoggerFactory()
+}
+
+// ResetExitHandler restores the default exit function, exit code and shutdown timeout and drops all shutdown hooks.
+func ResetExitHandler() {
+	exit = newExitHandler()
+}
@@ -0,0 +1,180 @@
+package slf4go_api
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// RootLoggerName is the name of the root logger, the ancestor of all named loggers.
+const RootLoggerName = ""
+
+// Provider is implemented by logging backends to hand out loggers via GetLogger.
+// Each provider package offers a NewProvider function creating a Provider for its backend.
+type Provider interface {
+	// RootLogger returns the logger all named loggers are derived from.
+	RootLogger() Slf4GoLogger
+}
+
+// ProviderFunc adapts a function returning the root logger to the Provider interface.
+type ProviderFunc func() Slf4GoLogger
+
+// RootLogger calls the function.
+func (f ProviderFunc) RootLogger() Slf4GoLogger {
+	return f()
+}
+
+// LoggerConfig configures a named logger and, by inheritance, all of its descendants.
+// Unset fields are inherited from the closest ancestor that sets them.
+type LoggerConfig struct {
+	// Tags are added as static tags to all entries. Tags of descendants are merged with the tags of their
+	// ancestors, tags of descendants taking precedence, and added to the static tags of the root logger of the
+	// provider (see AddStaticTags).
+	Tags LogTags
+	// ComponentLabel overrides the label the logger name is logged under (see WithAppComponentLabel).
+	ComponentLabel *string
+	// CallerReporting enables or disables caller reporting (see WithCallerReporting).
+	CallerReporting *bool
+	// StackTrace sets the stack trace threshold (see WithStackTrace).
+	StackTrace *LogLevel
+	// ComponentLevels sets the levels consulted before emitting entries (see WithComponentLevels).
+	// As the logger name is its component, the levels of named loggers can be controlled via ComponentLevels.SetLevel.
+	ComponentLevels *ComponentLevels
+}
+
+// loggerFactory holds the provider, the configuration of named loggers and the loggers handed out so far.
+// Each change increments generation, which causes all handed-out loggers to resolve their backend logger again.
+type loggerFactory struct {
+	mutex      sync.RWMutex
+	provider   Provider
+	configs    map[string]LoggerConfig
+	loggers    map[string]*namedLogger
+	generation atomic.Uint64
+}
+
+var factory = newLoggerFactory()
+
+func newLoggerFactory() *loggerFactory {
+	return &loggerFactory{
+		configs: make(map[string]LoggerConfig),
+		loggers: make(map[string]*namedLogger),
+	}
+}
+
+// RegisterProvider installs the provider all loggers returned by GetLogger log through. Loggers handed out
+// before, e.g. to libraries during package initialization, switch to the new provider with their next entry.
+// Until a provider is registered, GetLogger loggers discard all entries.
+func RegisterProvider(provider Provider) {
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+	factory.provider = provider
+	factory.generation.Add(1)
+}
+
+// ConfigureLogger sets the configuration of the named logger, replacing its previous configuration.
+// The configuration applies to the logger itself and to all of its descendants, e.g. configuring
+// "billing" affects "billing.invoice" and "billing.invoice.pdf", unless they override it.
+func ConfigureLogger(name string, config LoggerConfig) {
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+	factory.configs[name] = config
+	factory.generation.Add(1)
+}
+
+// GetLogger returns the logger with the given dot-separated hierarchical name, e.g. "billing.invoice.pdf".
+// The name is logged as component (see ForComponent), RootLoggerName returns the root logger.
+// Repeated calls with the same name return the same logger. The logger always reflects the currently
+// registered provider and configuration, so it can be obtained once and kept, e.g. in a package variable.
+func GetLogger(name string) Slf4GoLogger {
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+	if logger, ok := factory.loggers[name]; ok {
+		return logger
+	}
+	logger := &namedLogger{name: name}
+	factory.loggers[name] = logger
+	return logger
+}
+
+// LoggerNames returns the names of all loggers handed out by GetLogger so far.
+func LoggerNames() []string {
+	factory.mutex.RLock()
+	defer factory.mutex.RUnlock()
+	names := make([]string, 0, len(factory.loggers))
+	for name := range factory.loggers {
+		names = append(names, name)
+	}
+	return names
+}
+
+// resolveLogger creates the backend logger for the given name from the provider and the effective configuration.
+func resolveLogger(name string) Slf4GoLogger {
+	factory.mutex.RLock()
+	defer factory.mutex.RUnlock()
+	if factory.provider == nil {
+		return nopLogger{}
+	}
+	logger := factory.provider.RootLogger()
+	config := effectiveConfig(name)
+	if config.ComponentLabel != nil {
+		logger = logger.WithAppComponentLabel(*config.ComponentLabel)
+	}
+	if name != RootLoggerName {
+		logger = logger.ForComponent(AppComponent(name))
+	}
+	if len(config.Tags) > 0 {
+		logger = AddStaticTags(logger, config.Tags)
+	}
+	if config.CallerReporting != nil {
+		logger = logger.WithCallerReporting(*config.CallerReporting)
+	}
+	if config.StackTrace != nil {
+		logger = logger.WithStackTrace(*config.StackTrace)
+	}
+	if config.ComponentLevels != nil {
+		logger = logger.WithComponentLevels(config.ComponentLevels)
+	}
+	return logger
+}
+
+// effectiveConfig merges the configurations of the named logger and all of its ancestors.
+// The caller has to hold the factory mutex.
+func effectiveConfig(name string) LoggerConfig {
+	effective := LoggerConfig{Tags: make(LogTags)}
+	for _, ancestor := range ancestorNames(name) {
+		config, ok := factory.configs[ancestor]
+		if !ok {
+			continue
+		}
+		for key, value := range config.Tags {
+			effective.Tags[key] = value
+		}
+		if config.ComponentLabel != nil {
+			effective.ComponentLabel = config.ComponentLabel
+		}
+		if config.CallerReporting != nil {
+			effective.CallerReporting = config.CallerReporting
+		}
+		if config.StackTrace != nil {
+			effective.StackTrace = config.StackTrace
+		}
+		if config.ComponentLevels != nil {
+			effective.ComponentLevels = config.ComponentLevels
+		}
+	}
+	return effective
+}
+
+// ancestorNames returns the names from the root logger down to the given name,
+// e.g. "", "billing", "billing.invoice" for "billing.invoice".
+func ancestorNames(name string) []string {
+	names := []string{RootLoggerName}
+	if name == RootLoggerName {
+		return names
+	}
+	for i, r := range name {
+		if r == '.' {
+			names = append(names, name[:i])
+		}
+	}
+	return append(names, name)
+}
@@ -0,0 +1,146 @@
+package slf4go_api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLogger_WithoutProvider(t *testing.T) {
+	slf4go_api.ResetLoggerFactory()
+	logger := slf4go_api.GetLogger("billing")
+
+	assert.False(t, logger.IsEnabled(slf4go_api.Error))
+	assert.NotPanics(t, func() {
+		logger.ForComponent("test-service").ErrorWithTagsf(slf4go_api.LogTags{"key1": "val1"}, "test message")
+	})
+	assert.PanicsWithValue(t, "test message with name=beeblebrox", func() {
+		logger.Panicf("test message with name=%s", "beeblebrox")
+	})
+}
+
+func TestGetLogger_SameInstance(t *testing.T) {
+	slf4go_api.ResetLoggerFactory()
+	assert.Same(t, slf4go_api.GetLogger("billing"), slf4go_api.GetLogger("billing"))
+	assert.NotSame(t, slf4go_api.GetLogger("billing"), slf4go_api.GetLogger("billing.invoice"))
+
+	names := slf4go_api.LoggerNames()
+	sort.Strings(names)
+	assert.Equal(t, []string{"billing", "billing.invoice"}, names)
+}
+
+func TestGetLogger_Hierarchy(t *testing.T) {
+	slf4go_api.ResetLoggerFactory()
+	output := registerProvider()
+	callerReporting := true
+	componentLabel := "logger"
+	slf4go_api.ConfigureLogger(slf4go_api.RootLoggerName, slf4go_api.LoggerConfig{
+		Tags:           slf4go_api.LogTags{"service": "billing-service", "team": "platform"},
+		ComponentLabel: &componentLabel,
+	})
+	slf4go_api.ConfigureLogger("billing", slf4go_api.LoggerConfig{
+		Tags:            slf4go_api.LogTags{"team": "payments"},
+		CallerReporting: &callerReporting,
+	})
+	slf4go_api.ConfigureLogger("billing.invoice", slf4go_api.LoggerConfig{
+		Tags: slf4go_api.LogTags{"team": "invoicing"},
+	})
+
+	t.Run("root", func(t *testing.T) {
+		slf4go_api.GetLogger(slf4go_api.RootLoggerName).Infof("test message")
+		assert.Equal(t, map[string]interface{}{
+			"level":   "info",
+			"msg":     "test message",
+			"service": "billing-service",
+			"team":    "platform",
+		}, lastEntry(t, output))
+	})
+
+	t.Run("inherits-from-ancestors", func(t *testing.T) {
+		slf4go_api.GetLogger("billing.invoice.pdf").Infof("test message")
+		entry := lastEntry(t, output)
+		assert.Equal(t, "billing.invoice.pdf", entry["logger"])
+		assert.Equal(t, "billing-service", entry["service"])
+		assert.Equal(t, "invoicing", entry["team"])
+		assert.Contains(t, entry, slf4go_api.CallerTag)
+	})
+
+	t.Run("unrelated-names-do-not-inherit", func(t *testing.T) {
+		slf4go_api.GetLogger("billingreport").Infof("test message")
+		entry := lastEntry(t, output)
+		assert.Equal(t, "platform", entry["team"])
+		assert.NotContains(t, entry, slf4go_api.CallerTag)
+	})
+
+	t.Run("configuration-changes-apply-to-handed-out-loggers", func(t *testing.T) {
+		logger := slf4go_api.GetLogger("billing.invoice")
+		logger.Infof("test message")
+		assert.Equal(t, "invoicing", lastEntry(t, output)["team"])
+
+		slf4go_api.ConfigureLogger("billing.invoice", slf4go_api.LoggerConfig{})
+		logger.Infof("test message")
+		assert.Equal(t, "payments", lastEntry(t, output)["team"])
+	})
+}
+
+func TestGetLogger_ProviderRegisteredLater(t *testing.T) {
+	slf4go_api.ResetLoggerFactory()
+	logger := slf4go_api.GetLogger("billing")
+	derivedLogger := logger.WithError(errors.New("test error")).WithStaticTags(slf4go_api.LogTags{"key1": "val1"})
+
+	logger.Infof("discarded")
+	output := registerProvider()
+	derivedLogger.Infof("test message")
+	assert.Equal(t, map[string]interface{}{
+		"level":                           "info",
+		"msg":                             "test message",
+		"key1":                            "val1",
+		slf4go_api.ErrorTag:               "test error",
+		slf4go_api.DefaultAppComponentTag: "billing",
+	}, lastEntry(t, output))
+
+	otherOutput := registerProvider()
+	logger.Warnf("test message")
+	assert.Equal(t, 1, strings.Count(output.String(), "\n"))
+	assert.Equal(t, "warning", lastEntry(t, otherOutput)["level"])
+}
+
+func TestGetLogger_KeepsStaticTagsOfRootLogger(t *testing.T) {
+	slf4go_api.ResetLoggerFactory()
+	output := &bytes.Buffer{}
+	root := slf4go_native_provider.New(output, slf4go_native_provider.WithEncoder(slf4go_native_provider.JSONEncoder{})).
+		WithStaticTags(slf4go_api.LogTags{"service": "billing-service", "team": "platform"})
+	slf4go_api.RegisterProvider(slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger { return root }))
+	slf4go_api.ConfigureLogger("billing", slf4go_api.LoggerConfig{Tags: slf4go_api.LogTags{"team": "payments"}})
+
+	slf4go_api.GetLogger("billing").Infof("test message")
+	assert.Equal(t, map[string]interface{}{
+		"level":                           "info",
+		"msg":                             "test message",
+		"service":                         "billing-service",
+		"team":                            "payments",
+		slf4go_api.DefaultAppComponentTag: "billing",
+	}, lastEntry(t, output))
+}
+
+func registerProvider() *bytes.Buffer {
+	output := &bytes.Buffer{}
+	slf4go_api.RegisterProvider(slf4go_native_provider.NewProvider(output,
+		slf4go_native_provider.WithEncoder(slf4go_native_provider.JSONEncoder{})))
+	return output
+}
+
+func lastEntry(t *testing.T, output *bytes.Buffer) map[string]interface{} {
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	entry := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &entry))
+	delete(entry, "time")
+	return entry
+}
@@ -0,0 +1,229 @@
+package slf4go_api
+
+import (
+	"context"
+)
+
+// fatalCatchingLogger is the logger passed to the function run by CatchFatal. It passes the scope of CatchFatal
+// on to the backend logger as a context value with every entry, entries logged without context included, so that
+// ExitCtx reports their Fatal entries to CatchFatal. Loggers derived from it keep the scope.
+type fatalCatchingLogger struct {
+	target Slf4GoLogger
+	scope  *fatalScope
+}
+
+func (l *fatalCatchingLogger) derive(logger Slf4GoLogger) Slf4GoLogger {
+	return &fatalCatchingLogger{target: logger, scope: l.scope}
+}
+
+func (l *fatalCatchingLogger) ForComponent(component AppComponent) Slf4GoLogger {
+	return l.derive(l.target.ForComponent(component))
+}
+
+func (l *fatalCatchingLogger) WithAppComponentLabel(appComponentLabel string) Slf4GoLogger {
+	return l.derive(l.target.WithAppComponentLabel(appComponentLabel))
+}
+
+func (l *fatalCatchingLogger) WithStaticTags(tags LogTags) Slf4GoLogger {
+	return l.derive(l.target.WithStaticTags(tags))
+}
+
+func (l *fatalCatchingLogger) AddStaticTags(tags LogTags) Slf4GoLogger {
+	return l.derive(AddStaticTags(l.target, tags))
+}
+
+func (l *fatalCatchingLogger) WithError(err error) Slf4GoLogger {
+	return l.derive(l.target.WithError(err))
+}
+
+func (l *fatalCatchingLogger) WithCallerReporting(enabled bool) Slf4GoLogger {
+	return l.derive(l.target.WithCallerReporting(enabled))
+}
+
+func (l *fatalCatchingLogger) WithStackTrace(threshold LogLevel) Slf4GoLogger {
+	return l.derive(l.target.WithStackTrace(threshold))
+}
+
+func (l *fatalCatchingLogger) WithComponentLevels(levels *ComponentLevels) Slf4GoLogger {
+	return l.derive(l.target.WithComponentLevels(levels))
+}
+
+func (l *fatalCatchingLogger) IsEnabled(level LogLevel) bool {
+	return l.target.IsEnabled(level)
+}
+
+func (l *fatalCatchingLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(Debug)
+}
+
+func (l *fatalCatchingLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(Trace)
+}
+
+func (l *fatalCatchingLogger) Logf(level LogLevel, msgTemplate string, args ...interface{}) {
+	l.target.LogCtxf(withFatalScope(nil, l.scope), level, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) LogWithTagsf(level LogLevel, tags LogTags, msgTemplate string, args ...interface{}) {
+	l.target.LogWithTagsCtxf(withFatalScope(nil, l.scope), level, tags, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) LogErrf(level LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.target.WithError(err).LogCtxf(withFatalScope(nil, l.scope), level, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) LogCtxf(ctx context.Context, level LogLevel, msgTemplate string, args ...interface{}) {
+	l.target.LogCtxf(withFatalScope(ctx, l.scope), level, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) LogWithTagsCtxf(ctx context.Context, level LogLevel, tags LogTags, msgTemplate string, args ...interface{}) {
+	l.target.LogWithTagsCtxf(withFatalScope(ctx, l.scope), level, tags, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(Trace, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(Debug, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(Info, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(Warn, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(Error, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(Panic, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(Fatal, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) TraceWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Trace, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) DebugWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Debug, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) InfoWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Info, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarnWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarningWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Warn, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) ErrorWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Error, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) PanicWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Panic, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) FatalWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Fatal, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Trace, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Debug, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Info, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Warn, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Error, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Panic, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Fatal, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) TraceWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Trace, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) DebugWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Debug, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) InfoWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Info, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarnWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarningWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Warn, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) ErrorWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Error, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) PanicWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Panic, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) FatalWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Fatal, fields, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Warn, err, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Error, err, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Panic, err, msgTemplate, args...)
+}
+
+func (l *fatalCatchingLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Fatal, err, msgTemplate, args...)
+}
@@ -0,0 +1,157 @@
+package slf4go_api
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LazyValue defers the computation of an expensive log argument or tag value until the log entry is
+// actually emitted. Providers resolve lazy values only after level filtering, so the supplier is never
+// called for discarded entries. Create instances via Lazy.
+type LazyValue func() interface{}
+
+// Lazy wraps the given supplier into a LazyValue that can be passed as formatting argument or as tag value:
+//
+//	logger.Debugf("request body: %s", slf4go_api.Lazy(func() interface{} { return dump(request) }))
+func Lazy(supplier func() interface{}) LazyValue {
+	return supplier
+}
+
+// Value calls the supplier and returns the computed value.
+func (v LazyValue) Value() interface{} {
+	return v()
+}
+
+// Format implements fmt.Formatter by formatting the computed value with the same verb and flags.
+// Lazy arguments therefore produce the expected output even if they are formatted without being resolved.
+func (v LazyValue) Format(state fmt.State, verb rune) {
+	_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), v())
+}
+
+// LazyTagsValue defers the computation of a whole set of tags until the log entry is actually emitted.
+// It is passed as the value of a tag, whose key qualifies the supplied tags like a slog group: the tags supplied
+// for LogTags{"request": LazyTags(...)} are added as "request.id", "request.size", and so on. Resolved tags never
+// override tags that were set explicitly. Create instances via LazyTags, or via UnqualifiedLazyTags to add the
+// supplied tags as they are.
+type LazyTagsValue func() LogTags
+
+// UnqualifiedLazyTagsValue is a LazyTagsValue whose supplied tags are added as they are, without being qualified by
+// the key it is passed under. The key only tells several unqualified lazy tags apart and is not written.
+// Create instances via UnqualifiedLazyTags.
+type UnqualifiedLazyTagsValue func() LogTags
+
+// LazyTags wraps the given supplier into a LazyTagsValue that can be passed as tag value, e.g. to InfoWithTagsf
+// or WithStaticTags:
+//
+//	logger.DebugWithTagsf(slf4go_api.LogTags{"diff": slf4go_api.LazyTags(func() slf4go_api.LogTags {
+//		return slf4go_api.LogTags{"added": added(old, new), "removed": removed(old, new)}
+//	})}, "configuration changed")
+func LazyTags(supplier func() LogTags) LazyTagsValue {
+	return supplier
+}
+
+// Tags calls the supplier and returns the computed tags.
+func (v LazyTagsValue) Tags() LogTags {
+	return v()
+}
+
+// Format implements fmt.Formatter by formatting the computed tags, resolved themselves, with the same verb and
+// flags. Lazy tags therefore produce readable output even if they are written without being resolved.
+func (v LazyTagsValue) Format(state fmt.State, verb rune) {
+	_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), ResolveTags(v()))
+}
+
+// UnqualifiedLazyTags wraps the given supplier into an UnqualifiedLazyTagsValue that can be passed as tag value,
+// e.g. to InfoWithTagsf or WithStaticTags:
+//
+//	logger.DebugWithTagsf(slf4go_api.LogTags{"request": slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags {
+//		return slf4go_api.LogTags{"requestId": request.ID, "userId": request.UserID}
+//	})}, "request received")
+func UnqualifiedLazyTags(supplier func() LogTags) UnqualifiedLazyTagsValue {
+	return supplier
+}
+
+// Tags calls the supplier and returns the computed tags.
+func (v UnqualifiedLazyTagsValue) Tags() LogTags {
+	return v()
+}
+
+// Format implements fmt.Formatter like LazyTagsValue.Format.
+func (v UnqualifiedLazyTagsValue) Format(state fmt.State, verb rune) {
+	_, _ = fmt.Fprintf(state, fmt.FormatString(state, verb), ResolveTags(v()))
+}
+
+// ContainsLazy reports whether the given tags contain lazy values or lazy tags that need to be resolved.
+func ContainsLazy(tags LogTags) bool {
+	for _, value := range tags {
+		switch value.(type) {
+		case LazyValue, LazyTagsValue, UnqualifiedLazyTagsValue:
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveTags computes all lazy values and lazy tags contained in tags. If there is nothing to resolve,
+// tags is returned as is, otherwise a new map is returned and tags is left unchanged.
+// Providers call it after level filtering, right before emitting an entry.
+func ResolveTags(tags LogTags) LogTags {
+	if !ContainsLazy(tags) {
+		return tags
+	}
+	resolved := make(LogTags, len(tags))
+	var lazyTagsKeys []string
+	for key, value := range tags {
+		switch v := value.(type) {
+		case LazyTagsValue, UnqualifiedLazyTagsValue:
+			lazyTagsKeys = append(lazyTagsKeys, key)
+		case LazyValue:
+			resolved[key] = v()
+		default:
+			resolved[key] = value
+		}
+	}
+	// Supplied tags are added in the order of their keys, so that conflicts between them are resolved consistently.
+	sort.Strings(lazyTagsKeys)
+	supplied := make(LogTags)
+	for _, key := range lazyTagsKeys {
+		var prefix string
+		var lazyTags LogTags
+		switch v := tags[key].(type) {
+		case LazyTagsValue:
+			prefix, lazyTags = key+".", v()
+		case UnqualifiedLazyTagsValue:
+			lazyTags = v()
+		}
+		for suppliedKey, value := range ResolveTags(lazyTags) {
+			supplied[prefix+suppliedKey] = value
+		}
+	}
+	for key, value := range supplied {
+		if _, explicit := resolved[key]; !explicit {
+			resolved[key] = value
+		}
+	}
+	return resolved
+}
+
+// ResolveArgs computes all lazy values contained in the formatting arguments. If there is nothing to resolve,
+// args is returned as is, otherwise a new slice is returned and args is left unchanged.
+func ResolveArgs(args []interface{}) []interface{} {
+	var resolved []interface{}
+	for i, arg := range args {
+		lazyValue, ok := arg.(LazyValue)
+		if !ok {
+			continue
+		}
+		if resolved == nil {
+			resolved = make([]interface{}, len(args))
+			copy(resolved, args)
+		}
+		resolved[i] = lazyValue()
+	}
+	if resolved == nil {
+		return args
+	}
+	return resolved
+}
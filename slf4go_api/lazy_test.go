@@ -0,0 +1,86 @@
+package slf4go_api
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazy(t *testing.T) {
+	evaluations := 0
+	lazyValue := Lazy(func() interface{} {
+		evaluations++
+		return 42
+	})
+	assert.Equal(t, 0, evaluations)
+
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, 42, lazyValue.Value())
+	})
+
+	t.Run("format", func(t *testing.T) {
+		assert.Equal(t, "value=42 padded=  42 hex=0x2a", fmt.Sprintf("value=%v padded=%4d hex=%#x", lazyValue, lazyValue, lazyValue))
+	})
+}
+
+func TestResolveArgs(t *testing.T) {
+	t.Run("without-lazy-values", func(t *testing.T) {
+		args := []interface{}{"beeblebrox", 42}
+		resolved := ResolveArgs(args)
+		assert.Equal(t, args, resolved)
+		assert.Same(t, &args[0], &resolved[0])
+	})
+
+	t.Run("with-lazy-values", func(t *testing.T) {
+		args := []interface{}{"beeblebrox", Lazy(func() interface{} { return 42 })}
+		assert.Equal(t, []interface{}{"beeblebrox", 42}, ResolveArgs(args))
+		assert.IsType(t, LazyValue(nil), args[1])
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, ResolveArgs(nil))
+	})
+}
+
+func TestResolveTags(t *testing.T) {
+	t.Run("without-lazy-values", func(t *testing.T) {
+		tags := LogTags{"key1": "val1"}
+		assert.False(t, ContainsLazy(tags))
+		assert.Equal(t, LogTags{"key1": "val1"}, ResolveTags(tags))
+	})
+
+	t.Run("lazy-values", func(t *testing.T) {
+		tags := LogTags{"key1": "val1", "key2": Lazy(func() interface{} { return "val2" })}
+		assert.True(t, ContainsLazy(tags))
+		assert.Equal(t, LogTags{"key1": "val1", "key2": "val2"}, ResolveTags(tags))
+		assert.IsType(t, LazyValue(nil), tags["key2"])
+	})
+
+	t.Run("lazy-tags", func(t *testing.T) {
+		tags := LogTags{"lazy": UnqualifiedLazyTags(func() LogTags {
+			return LogTags{"key1": "val1", "key2": Lazy(func() interface{} { return "val2" })}
+		})}
+		assert.True(t, ContainsLazy(tags))
+		assert.Equal(t, LogTags{"key1": "val1", "key2": "val2"}, ResolveTags(tags))
+	})
+
+	t.Run("qualified-lazy-tags", func(t *testing.T) {
+		tags := LogTags{"request": LazyTags(func() LogTags {
+			return LogTags{"id": "abc-123", "user": LazyTags(func() LogTags { return LogTags{"name": "beeblebrox"} })}
+		})}
+		assert.Equal(t, LogTags{"request.id": "abc-123", "request.user.name": "beeblebrox"}, ResolveTags(tags))
+	})
+
+	t.Run("explicit-tags-take-precedence", func(t *testing.T) {
+		tags := LogTags{"key1": "val1", "lazy": UnqualifiedLazyTags(func() LogTags {
+			return LogTags{"key1": "lazy_val1", "key2": "lazy_val2"}
+		})}
+		assert.Equal(t, LogTags{"key1": "val1", "key2": "lazy_val2"}, ResolveTags(tags))
+	})
+
+	t.Run("format", func(t *testing.T) {
+		lazyTags := LazyTags(func() LogTags { return LogTags{"key1": Lazy(func() interface{} { return "val1" })} })
+		assert.Equal(t, "request=map[key1:val1]", fmt.Sprintf("request=%v", lazyTags))
+	})
+}
@@ -0,0 +1,90 @@
+package slf4go_api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownLevel is returned (wrapped) when parsing or marshalling an unknown log level.
+var ErrUnknownLevel = errors.New("unknown log level")
+
+// levelAliases maps the accepted lower-case names onto the log levels.
+var levelAliases = map[string]LogLevel{
+	"fatal":   Fatal,
+	"panic":   Panic,
+	"error":   Error,
+	"err":     Error,
+	"warning": Warn,
+	"warn":    Warn,
+	"info":    Info,
+	"debug":   Debug,
+	"trace":   Trace,
+}
+
+// ParseLevel converts the name of a log level into the level. Names are case-insensitive and surrounding
+// whitespace is ignored. Besides the names returned by LogLevel.String, "warn" and "err" are accepted.
+// Unknown names yield an error wrapping ErrUnknownLevel.
+func ParseLevel(name string) (LogLevel, error) {
+	if level, ok := levelAliases[strings.ToLower(strings.TrimSpace(name))]; ok {
+		return level, nil
+	}
+	return 0, fmt.Errorf("%w '%s'", ErrUnknownLevel, name)
+}
+
+// IsValid reports whether the level is one of AllLevels.
+func (level LogLevel) IsValid() bool {
+	return level <= Trace
+}
+
+// MarshalText implements encoding.TextMarshaler, e.g. for YAML. Invalid levels yield an error wrapping ErrUnknownLevel.
+func (level LogLevel) MarshalText() ([]byte, error) {
+	if !level.IsValid() {
+		return nil, fmt.Errorf("%w %d", ErrUnknownLevel, uint32(level))
+	}
+	return []byte(level.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler via ParseLevel.
+func (level *LogLevel) UnmarshalText(text []byte) error {
+	parsed, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*level = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, encoding the level as its name.
+func (level LogLevel) MarshalJSON() ([]byte, error) {
+	text, err := level.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Besides names, it accepts the numeric values levels were encoded
+// as before LogLevel implemented json.Marshaler.
+func (level *LogLevel) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		return level.UnmarshalText([]byte(name))
+	}
+	number, err := strconv.ParseUint(string(data), 10, 32)
+	if err != nil || !LogLevel(number).IsValid() {
+		return fmt.Errorf("%w %s", ErrUnknownLevel, data)
+	}
+	*level = LogLevel(number)
+	return nil
+}
+
+// Set implements flag.Value via ParseLevel, so levels can be passed as command-line flags:
+//
+//	level := slf4go_api.Info
+//	flag.Var(&level, "log-level", "log level")
+func (level *LogLevel) Set(name string) error {
+	return level.UnmarshalText([]byte(name))
+}
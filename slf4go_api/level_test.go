@@ -0,0 +1,129 @@
+package slf4go_api
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLevel(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		expected LogLevel
+	}{
+		{"fatal", Fatal},
+		{"panic", Panic},
+		{"error", Error},
+		{"err", Error},
+		{"warning", Warn},
+		{"warn", Warn},
+		{"info", Info},
+		{"debug", Debug},
+		{"trace", Trace},
+		{"TRACE", Trace},
+		{" Warn\n", Warn},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			level, err := ParseLevel(scenario.name)
+			require.NoError(t, err)
+			assert.Equal(t, scenario.expected, level)
+		})
+	}
+
+	t.Run("round-trip", func(t *testing.T) {
+		for _, level := range AllLevels {
+			parsed, err := ParseLevel(level.String())
+			require.NoError(t, err)
+			assert.Equal(t, level, parsed)
+		}
+	})
+
+	for _, name := range []string{"", "verbose", "unknown", "3"} {
+		t.Run("unknown-"+name, func(t *testing.T) {
+			_, err := ParseLevel(name)
+			assert.ErrorIs(t, err, ErrUnknownLevel)
+			assert.EqualError(t, err, fmt.Sprintf("unknown log level '%s'", name))
+		})
+	}
+}
+
+func TestLogLevel_String(t *testing.T) {
+	assert.Equal(t, "warning", fmt.Sprint(Warn))
+	assert.Equal(t, "unknown", LogLevel(666).String())
+	assert.Equal(t, Trace.String(), Trace.Stringer())
+}
+
+func TestLogLevel_Text(t *testing.T) {
+	text, err := Debug.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "debug", string(text))
+
+	_, err = LogLevel(666).MarshalText()
+	assert.ErrorIs(t, err, ErrUnknownLevel)
+
+	var level LogLevel
+	require.NoError(t, level.UnmarshalText([]byte("ERR")))
+	assert.Equal(t, Error, level)
+	assert.ErrorIs(t, level.UnmarshalText([]byte("verbose")), ErrUnknownLevel)
+	assert.Equal(t, Error, level)
+}
+
+func TestLogLevel_JSON(t *testing.T) {
+	type config struct {
+		Level LogLevel `json:"level"`
+	}
+
+	t.Run("marshal", func(t *testing.T) {
+		data, err := json.Marshal(config{Level: Warn})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"level": "warning"}`, string(data))
+
+		_, err = json.Marshal(config{Level: NoStackTrace})
+		assert.ErrorIs(t, err, ErrUnknownLevel)
+	})
+
+	scenarios := []struct {
+		name     string
+		data     string
+		expected LogLevel
+	}{
+		{"name", `{"level": "debug"}`, Debug},
+		{"alias", `{"level": "Warn"}`, Warn},
+		{"number", `{"level": 6}`, Trace},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var c config
+			require.NoError(t, json.Unmarshal([]byte(scenario.data), &c))
+			assert.Equal(t, scenario.expected, c.Level)
+		})
+	}
+
+	for _, data := range []string{`{"level": "verbose"}`, `{"level": 7}`, `{"level": true}`} {
+		t.Run("invalid-"+data, func(t *testing.T) {
+			var c config
+			assert.ErrorIs(t, json.Unmarshal([]byte(data), &c), ErrUnknownLevel)
+		})
+	}
+}
+
+func TestLogLevel_Flag(t *testing.T) {
+	level := Info
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.Var(&level, "log-level", "log level")
+
+	require.NoError(t, flags.Parse([]string{"-log-level", "trace"}))
+	assert.Equal(t, Trace, level)
+
+	flags.SetOutput(io.Discard)
+	assert.Error(t, flags.Parse([]string{"-log-level", "verbose"}))
+	assert.Equal(t, Trace, level)
+}
@@ -0,0 +1,255 @@
+package slf4go_api
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// namedLogger is the logger handed out by GetLogger. It delegates to a backend logger resolved from the
+// registered provider and the configuration of its name, and resolves it again whenever either changes.
+// Loggers derived from a namedLogger record their derivations, so they follow these changes as well.
+type namedLogger struct {
+	name        string
+	derivations []func(logger Slf4GoLogger) Slf4GoLogger
+	resolved    atomic.Pointer[resolvedLogger]
+}
+
+type resolvedLogger struct {
+	generation uint64
+	logger     Slf4GoLogger
+}
+
+// logger returns the backend logger of the current factory generation.
+func (l *namedLogger) logger() Slf4GoLogger {
+	generation := factory.generation.Load()
+	if resolved := l.resolved.Load(); resolved != nil && resolved.generation == generation {
+		return resolved.logger
+	}
+	logger := resolveLogger(l.name)
+	for _, derive := range l.derivations {
+		logger = derive(logger)
+	}
+	l.resolved.Store(&resolvedLogger{generation: generation, logger: logger})
+	return logger
+}
+
+func (l *namedLogger) derive(derivation func(logger Slf4GoLogger) Slf4GoLogger) Slf4GoLogger {
+	derivations := make([]func(logger Slf4GoLogger) Slf4GoLogger, len(l.derivations), len(l.derivations)+1)
+	copy(derivations, l.derivations)
+	return &namedLogger{
+		name:        l.name,
+		derivations: append(derivations, derivation),
+	}
+}
+
+func (l *namedLogger) ForComponent(component AppComponent) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.ForComponent(component) })
+}
+
+func (l *namedLogger) WithAppComponentLabel(appComponentLabel string) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithAppComponentLabel(appComponentLabel) })
+}
+
+func (l *namedLogger) WithStaticTags(tags LogTags) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithStaticTags(tags) })
+}
+
+func (l *namedLogger) AddStaticTags(tags LogTags) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return AddStaticTags(logger, tags) })
+}
+
+func (l *namedLogger) WithError(err error) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithError(err) })
+}
+
+func (l *namedLogger) WithCallerReporting(enabled bool) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithCallerReporting(enabled) })
+}
+
+func (l *namedLogger) WithStackTrace(threshold LogLevel) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithStackTrace(threshold) })
+}
+
+func (l *namedLogger) WithComponentLevels(levels *ComponentLevels) Slf4GoLogger {
+	return l.derive(func(logger Slf4GoLogger) Slf4GoLogger { return logger.WithComponentLevels(levels) })
+}
+
+func (l *namedLogger) IsEnabled(level LogLevel) bool {
+	return l.logger().IsEnabled(level)
+}
+
+func (l *namedLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(Debug)
+}
+
+func (l *namedLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(Trace)
+}
+
+func (l *namedLogger) Logf(level LogLevel, msgTemplate string, args ...interface{}) {
+	l.logger().Logf(level, msgTemplate, args...)
+}
+
+func (l *namedLogger) LogWithTagsf(level LogLevel, tags LogTags, msgTemplate string, args ...interface{}) {
+	l.logger().LogWithTagsf(level, tags, msgTemplate, args...)
+}
+
+func (l *namedLogger) LogErrf(level LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.logger().LogErrf(level, err, msgTemplate, args...)
+}
+
+func (l *namedLogger) LogCtxf(ctx context.Context, level LogLevel, msgTemplate string, args ...interface{}) {
+	l.logger().LogCtxf(ctx, level, msgTemplate, args...)
+}
+
+func (l *namedLogger) LogWithTagsCtxf(ctx context.Context, level LogLevel, tags LogTags, msgTemplate string, args ...interface{}) {
+	l.logger().LogWithTagsCtxf(ctx, level, tags, msgTemplate, args...)
+}
+
+func (l *namedLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(Trace, msgTemplate, args...)
+}
+
+func (l *namedLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(Debug, msgTemplate, args...)
+}
+
+func (l *namedLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(Info, msgTemplate, args...)
+}
+
+func (l *namedLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *namedLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(Warn, msgTemplate, args...)
+}
+
+func (l *namedLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(Error, msgTemplate, args...)
+}
+
+func (l *namedLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(Panic, msgTemplate, args...)
+}
+
+func (l *namedLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(Fatal, msgTemplate, args...)
+}
+
+func (l *namedLogger) TraceWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Trace, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) DebugWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Debug, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) InfoWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Info, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarnWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarningWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Warn, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) ErrorWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Error, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) PanicWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Panic, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) FatalWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Fatal, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Trace, msgTemplate, args...)
+}
+
+func (l *namedLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Debug, msgTemplate, args...)
+}
+
+func (l *namedLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Info, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Warn, msgTemplate, args...)
+}
+
+func (l *namedLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Error, msgTemplate, args...)
+}
+
+func (l *namedLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Panic, msgTemplate, args...)
+}
+
+func (l *namedLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Fatal, msgTemplate, args...)
+}
+
+func (l *namedLogger) TraceWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Trace, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) DebugWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Debug, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) InfoWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Info, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarnWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarningWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Warn, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) ErrorWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Error, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) PanicWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Panic, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) FatalWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Fatal, fields, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *namedLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Warn, err, msgTemplate, args...)
+}
+
+func (l *namedLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Error, err, msgTemplate, args...)
+}
+
+func (l *namedLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Panic, err, msgTemplate, args...)
+}
+
+func (l *namedLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Fatal, err, msgTemplate, args...)
+}
@@ -0,0 +1,227 @@
+package slf4go_api
+
+import (
+	"context"
+	"fmt"
+)
+
+// nopLogger discards all entries. It is used by GetLogger loggers until a provider is registered.
+// Fatal entries still terminate the program and Panic entries still panic.
+type nopLogger struct{}
+
+func (l nopLogger) ForComponent(AppComponent) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithAppComponentLabel(string) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithStaticTags(LogTags) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) AddStaticTags(LogTags) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithError(error) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithCallerReporting(bool) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithStackTrace(LogLevel) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) WithComponentLevels(*ComponentLevels) Slf4GoLogger {
+	return l
+}
+
+func (l nopLogger) IsEnabled(LogLevel) bool {
+	return false
+}
+
+func (l nopLogger) IsDebugEnabled() bool {
+	return false
+}
+
+func (l nopLogger) IsTraceEnabled() bool {
+	return false
+}
+
+func (l nopLogger) Logf(level LogLevel, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(context.Background(), level, msgTemplate, args...)
+}
+
+func (l nopLogger) LogWithTagsf(level LogLevel, _ LogTags, msgTemplate string, args ...interface{}) {
+	l.Logf(level, msgTemplate, args...)
+}
+
+func (l nopLogger) LogErrf(level LogLevel, _ error, msgTemplate string, args ...interface{}) {
+	l.Logf(level, msgTemplate, args...)
+}
+
+func (l nopLogger) LogCtxf(ctx context.Context, level LogLevel, msgTemplate string, args ...interface{}) {
+	switch level {
+	case Fatal:
+		ExitCtx(ctx, fmt.Sprintf(msgTemplate, ResolveArgs(args)...))
+	case Panic:
+		panic(fmt.Sprintf(msgTemplate, ResolveArgs(args)...))
+	}
+}
+
+func (l nopLogger) LogWithTagsCtxf(ctx context.Context, level LogLevel, _ LogTags, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, level, msgTemplate, args...)
+}
+
+func (l nopLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(Trace, msgTemplate, args...)
+}
+
+func (l nopLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(Debug, msgTemplate, args...)
+}
+
+func (l nopLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(Info, msgTemplate, args...)
+}
+
+func (l nopLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l nopLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(Warn, msgTemplate, args...)
+}
+
+func (l nopLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(Error, msgTemplate, args...)
+}
+
+func (l nopLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(Panic, msgTemplate, args...)
+}
+
+func (l nopLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(Fatal, msgTemplate, args...)
+}
+
+func (l nopLogger) TraceWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Trace, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) DebugWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Debug, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) InfoWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Info, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) WarnWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l nopLogger) WarningWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Warn, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) ErrorWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Error, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) PanicWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Panic, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) FatalWithTagsf(fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(Fatal, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Trace, msgTemplate, args...)
+}
+
+func (l nopLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Debug, msgTemplate, args...)
+}
+
+func (l nopLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Info, msgTemplate, args...)
+}
+
+func (l nopLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l nopLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Warn, msgTemplate, args...)
+}
+
+func (l nopLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Error, msgTemplate, args...)
+}
+
+func (l nopLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Panic, msgTemplate, args...)
+}
+
+func (l nopLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, Fatal, msgTemplate, args...)
+}
+
+func (l nopLogger) TraceWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Trace, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) DebugWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Debug, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) InfoWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Info, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) WarnWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) WarningWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Warn, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) ErrorWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Error, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) PanicWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Panic, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) FatalWithTagsCtxf(ctx context.Context, fields LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, Fatal, fields, msgTemplate, args...)
+}
+
+func (l nopLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l nopLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Warn, err, msgTemplate, args...)
+}
+
+func (l nopLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Error, err, msgTemplate, args...)
+}
+
+func (l nopLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Panic, err, msgTemplate, args...)
+}
+
+func (l nopLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(Fatal, err, msgTemplate, args...)
+}
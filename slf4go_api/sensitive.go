@@ -0,0 +1,49 @@
+package slf4go_api
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// RedactedPlaceholder is written instead of sensitive values that are not masked otherwise.
+const RedactedPlaceholder = "[REDACTED]"
+
+// SensitiveValue marks a log argument or tag value as sensitive, e.g. a password or an access token.
+// Redacting loggers (see slf4go_redact) mask it regardless of its key and content, all other loggers write
+// RedactedPlaceholder instead of the value. Create instances via Sensitive.
+type SensitiveValue struct {
+	value interface{}
+}
+
+// Sensitive wraps the given value into a SensitiveValue that can be passed as formatting argument or as tag value:
+//
+//	logger.InfoWithTagsf(slf4go_api.LogTags{"apiKey": slf4go_api.Sensitive(apiKey)}, "calling payment provider")
+func Sensitive(value interface{}) SensitiveValue {
+	return SensitiveValue{value: value}
+}
+
+// Value returns the wrapped value. It must not be logged.
+func (v SensitiveValue) Value() interface{} {
+	return v.value
+}
+
+// String implements fmt.Stringer by returning RedactedPlaceholder.
+func (v SensitiveValue) String() string {
+	return RedactedPlaceholder
+}
+
+// Format implements fmt.Formatter by writing RedactedPlaceholder for all verbs, so that the wrapped value is not
+// written even if it is formatted with %#v or %d.
+func (v SensitiveValue) Format(state fmt.State, verb rune) {
+	if verb == 'q' {
+		_, _ = state.Write([]byte(strconv.Quote(RedactedPlaceholder)))
+		return
+	}
+	_, _ = state.Write([]byte(RedactedPlaceholder))
+}
+
+// MarshalText implements encoding.TextMarshaler by returning RedactedPlaceholder, which keeps the wrapped value
+// out of JSON and text encoders.
+func (v SensitiveValue) MarshalText() ([]byte, error) {
+	return []byte(RedactedPlaceholder), nil
+}
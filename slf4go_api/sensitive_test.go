@@ -0,0 +1,30 @@
+package slf4go_api
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitive(t *testing.T) {
+	sensitive := Sensitive("s3cr3t")
+
+	t.Run("value", func(t *testing.T) {
+		assert.Equal(t, "s3cr3t", sensitive.Value())
+	})
+
+	t.Run("format", func(t *testing.T) {
+		assert.Equal(t, `password=[REDACTED] [REDACTED] [REDACTED] "[REDACTED]"`,
+			fmt.Sprintf("password=%v %s %#v %q", sensitive, sensitive, sensitive, sensitive))
+		assert.Equal(t, "[REDACTED]", fmt.Sprint(Sensitive(4111111111111111)))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		encoded, err := json.Marshal(map[string]interface{}{"password": sensitive})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"password":"[REDACTED]"}`, string(encoded))
+	})
+}
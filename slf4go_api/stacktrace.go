@@ -0,0 +1,72 @@
+package slf4go_api
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// StackTraceTag is the key under which providers log the stack trace of entries if stack trace capture is enabled.
+const StackTraceTag string = "stacktrace"
+
+// NoStackTrace can be passed to WithStackTrace to disable stack trace capture. It is the default of all providers.
+const NoStackTrace LogLevel = ^LogLevel(0)
+
+// StackFrame describes a single frame of a StackTrace.
+type StackFrame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// String renders the frame as "function (file:line)".
+func (f StackFrame) String() string {
+	return f.Function + " (" + f.File + ":" + strconv.Itoa(f.Line) + ")"
+}
+
+// StackTrace is the structured stack trace providers log under StackTraceTag, the innermost frame first.
+type StackTrace []StackFrame
+
+// String renders the stack trace with one frame per line.
+func (t StackTrace) String() string {
+	frames := make([]string, len(t))
+	for i, frame := range t {
+		frames[i] = frame.String()
+	}
+	return strings.Join(frames, "\n")
+}
+
+// CapturesStackTrace reports whether a stack trace has to be captured for an entry of the given level,
+// i.e. whether level is at least as severe as threshold. Thresholds that are no valid LogLevel,
+// like NoStackTrace, never capture stack traces.
+func CapturesStackTrace(level LogLevel, threshold LogLevel) bool {
+	return threshold <= Trace && level <= threshold
+}
+
+// CaptureStackTrace returns the stack trace of the calling goroutine. Frames of all registered logging
+// packages (see RegisterLoggingPackage) and of the Go runtime are removed, so the trace starts at the call site.
+func CaptureStackTrace() StackTrace {
+	pcs := make([]uintptr, maxStackDepth)
+	// skip runtime.Callers and CaptureStackTrace
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	var stackTrace StackTrace
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "" && !isLoggingFrame(frame) && !isRuntimeFrame(frame) {
+			stackTrace = append(stackTrace, StackFrame{Function: frame.Function, File: frame.File, Line: frame.Line})
+		}
+		if !more {
+			return stackTrace
+		}
+	}
+}
+
+// StackTraceTags returns the tags providers add to an entry that requires a stack trace:
+// the CaptureStackTrace under StackTraceTag.
+func StackTraceTags() LogTags {
+	return LogTags{StackTraceTag: CaptureStackTrace()}
+}
+
+func isRuntimeFrame(frame runtime.Frame) bool {
+	return packagePath(frame.Function) == "runtime"
+}
@@ -0,0 +1,51 @@
+package slf4go_api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapturesStackTrace(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		level     slf4go_api.LogLevel
+		threshold slf4go_api.LogLevel
+		expected  bool
+	}{
+		{"fatal-at-error", slf4go_api.Fatal, slf4go_api.Error, true},
+		{"error-at-error", slf4go_api.Error, slf4go_api.Error, true},
+		{"warn-at-error", slf4go_api.Warn, slf4go_api.Error, false},
+		{"trace-at-trace", slf4go_api.Trace, slf4go_api.Trace, true},
+		{"fatal-at-no-stack-trace", slf4go_api.Fatal, slf4go_api.NoStackTrace, false},
+		{"fatal-at-unknown", slf4go_api.Fatal, 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4go_api.CapturesStackTrace(scenario.level, scenario.threshold))
+		})
+	}
+}
+
+func TestCaptureStackTrace(t *testing.T) {
+	stackTrace := slf4go_api.CaptureStackTrace()
+	if !assert.NotEmpty(t, stackTrace) {
+		return
+	}
+	assert.Equal(t, "github.com/MariusSchmidt/slf4go/slf4go_api_test.TestCaptureStackTrace", stackTrace[0].Function)
+	assert.True(t, strings.HasSuffix(stackTrace[0].File, "/stacktrace_test.go"), stackTrace[0].File)
+	for _, frame := range stackTrace {
+		assert.False(t, strings.HasPrefix(frame.Function, "runtime."), frame.Function)
+	}
+}
+
+func TestStackTrace_String(t *testing.T) {
+	stackTrace := slf4go_api.StackTrace{
+		{Function: "main.handle", File: "/app/main.go", Line: 42},
+		{Function: "main.main", File: "/app/main.go", Line: 12},
+	}
+	assert.Equal(t, "main.handle (/app/main.go:42)\nmain.main (/app/main.go:12)", stackTrace.String())
+}
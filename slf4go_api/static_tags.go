@@ -0,0 +1,19 @@
+package slf4go_api
+
+// StaticTagsAdder is implemented by loggers that can add static tags to the static tags they already hold.
+// All loggers of this module implement it, see AddStaticTags.
+type StaticTagsAdder interface {
+	// AddStaticTags creates a new Slf4GoLogger instance with the given tags added to the static tags of the
+	// original logger, taking precedence over them.
+	AddStaticTags(tags LogTags) Slf4GoLogger
+}
+
+// AddStaticTags derives a logger with the given tags added to the static tags of logger, whereas
+// Slf4GoLogger.WithStaticTags replaces them. Loggers not implementing StaticTagsAdder get the given tags via
+// WithStaticTags, so their previous static tags are dropped.
+func AddStaticTags(logger Slf4GoLogger, tags LogTags) Slf4GoLogger {
+	if adder, ok := logger.(StaticTagsAdder); ok {
+		return adder.AddStaticTags(tags)
+	}
+	return logger.WithStaticTags(tags)
+}
@@ -0,0 +1,33 @@
+package slf4go_api_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_api/test_mocks"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+)
+
+func TestAddStaticTags(t *testing.T) {
+	t.Run("added-to-static-tags", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		logger := slf4go_native_provider.New(output, slf4go_native_provider.WithEncoder(slf4go_native_provider.JSONEncoder{})).
+			WithStaticTags(slf4go_api.LogTags{"key1": "val1", "key2": "val2"})
+
+		slf4go_api.AddStaticTags(logger, slf4go_api.LogTags{"key2": "added"}).Infof("test message")
+		assert.Equal(t, map[string]interface{}{"level": "info", "msg": "test message", "key1": "val1", "key2": "added"},
+			lastEntry(t, output))
+	})
+
+	t.Run("replacing-without-adder", func(t *testing.T) {
+		mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+		derivedLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+		mockLogger.EXPECT().WithStaticTags(slf4go_api.LogTags{"key2": "added"}).Return(derivedLogger)
+
+		assert.Same(t, derivedLogger, slf4go_api.AddStaticTags(mockLogger, slf4go_api.LogTags{"key2": "added"}))
+	})
+}
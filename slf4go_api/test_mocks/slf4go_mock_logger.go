@@ -5,6 +5,7 @@
 package test_mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	slf4go_api "github.com/MariusSchmidt/slf4go/slf4go_api"
@@ -34,6 +35,40 @@ func (m *MockSlf4GoLogger) EXPECT() *MockSlf4GoLoggerMockRecorder {
 	return m.recorder
 }
 
+// DebugCtxf mocks base method.
+func (m *MockSlf4GoLogger) DebugCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DebugCtxf", varargs...)
+}
+
+// DebugCtxf indicates an expected call of DebugCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) DebugCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).DebugCtxf), varargs...)
+}
+
+// DebugWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) DebugWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "DebugWithTagsCtxf", varargs...)
+}
+
+// DebugWithTagsCtxf indicates an expected call of DebugWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) DebugWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebugWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).DebugWithTagsCtxf), varargs...)
+}
+
 // DebugWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) DebugWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -68,6 +103,57 @@ func (mr *MockSlf4GoLoggerMockRecorder) Debugf(arg0 interface{}, arg1 ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debugf", reflect.TypeOf((*MockSlf4GoLogger)(nil).Debugf), varargs...)
 }
 
+// ErrorCtxf mocks base method.
+func (m *MockSlf4GoLogger) ErrorCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "ErrorCtxf", varargs...)
+}
+
+// ErrorCtxf indicates an expected call of ErrorCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) ErrorCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).ErrorCtxf), varargs...)
+}
+
+// ErrorErrf mocks base method.
+func (m *MockSlf4GoLogger) ErrorErrf(arg0 error, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "ErrorErrf", varargs...)
+}
+
+// ErrorErrf indicates an expected call of ErrorErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) ErrorErrf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).ErrorErrf), varargs...)
+}
+
+// ErrorWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) ErrorWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "ErrorWithTagsCtxf", varargs...)
+}
+
+// ErrorWithTagsCtxf indicates an expected call of ErrorWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) ErrorWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ErrorWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).ErrorWithTagsCtxf), varargs...)
+}
+
 // ErrorWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) ErrorWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -102,6 +188,57 @@ func (mr *MockSlf4GoLoggerMockRecorder) Errorf(arg0 interface{}, arg1 ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Errorf", reflect.TypeOf((*MockSlf4GoLogger)(nil).Errorf), varargs...)
 }
 
+// FatalCtxf mocks base method.
+func (m *MockSlf4GoLogger) FatalCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "FatalCtxf", varargs...)
+}
+
+// FatalCtxf indicates an expected call of FatalCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) FatalCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FatalCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).FatalCtxf), varargs...)
+}
+
+// FatalErrf mocks base method.
+func (m *MockSlf4GoLogger) FatalErrf(arg0 error, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "FatalErrf", varargs...)
+}
+
+// FatalErrf indicates an expected call of FatalErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) FatalErrf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FatalErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).FatalErrf), varargs...)
+}
+
+// FatalWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) FatalWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "FatalWithTagsCtxf", varargs...)
+}
+
+// FatalWithTagsCtxf indicates an expected call of FatalWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) FatalWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FatalWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).FatalWithTagsCtxf), varargs...)
+}
+
 // FatalWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) FatalWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -150,6 +287,40 @@ func (mr *MockSlf4GoLoggerMockRecorder) ForComponent(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForComponent", reflect.TypeOf((*MockSlf4GoLogger)(nil).ForComponent), arg0)
 }
 
+// InfoCtxf mocks base method.
+func (m *MockSlf4GoLogger) InfoCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "InfoCtxf", varargs...)
+}
+
+// InfoCtxf indicates an expected call of InfoCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) InfoCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfoCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).InfoCtxf), varargs...)
+}
+
+// InfoWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) InfoWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "InfoWithTagsCtxf", varargs...)
+}
+
+// InfoWithTagsCtxf indicates an expected call of InfoWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) InfoWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InfoWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).InfoWithTagsCtxf), varargs...)
+}
+
 // InfoWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) InfoWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -184,6 +355,99 @@ func (mr *MockSlf4GoLoggerMockRecorder) Infof(arg0 interface{}, arg1 ...interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Infof", reflect.TypeOf((*MockSlf4GoLogger)(nil).Infof), varargs...)
 }
 
+// IsDebugEnabled mocks base method.
+func (m *MockSlf4GoLogger) IsDebugEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDebugEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsDebugEnabled indicates an expected call of IsDebugEnabled.
+func (mr *MockSlf4GoLoggerMockRecorder) IsDebugEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDebugEnabled", reflect.TypeOf((*MockSlf4GoLogger)(nil).IsDebugEnabled))
+}
+
+// IsEnabled mocks base method.
+func (m *MockSlf4GoLogger) IsEnabled(arg0 slf4go_api.LogLevel) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsEnabled", arg0)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsEnabled indicates an expected call of IsEnabled.
+func (mr *MockSlf4GoLoggerMockRecorder) IsEnabled(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsEnabled", reflect.TypeOf((*MockSlf4GoLogger)(nil).IsEnabled), arg0)
+}
+
+// IsTraceEnabled mocks base method.
+func (m *MockSlf4GoLogger) IsTraceEnabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsTraceEnabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsTraceEnabled indicates an expected call of IsTraceEnabled.
+func (mr *MockSlf4GoLoggerMockRecorder) IsTraceEnabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTraceEnabled", reflect.TypeOf((*MockSlf4GoLogger)(nil).IsTraceEnabled))
+}
+
+// LogCtxf mocks base method.
+func (m *MockSlf4GoLogger) LogCtxf(arg0 context.Context, arg1 slf4go_api.LogLevel, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "LogCtxf", varargs...)
+}
+
+// LogCtxf indicates an expected call of LogCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) LogCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).LogCtxf), varargs...)
+}
+
+// LogErrf mocks base method.
+func (m *MockSlf4GoLogger) LogErrf(arg0 slf4go_api.LogLevel, arg1 error, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "LogErrf", varargs...)
+}
+
+// LogErrf indicates an expected call of LogErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) LogErrf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).LogErrf), varargs...)
+}
+
+// LogWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) LogWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogLevel, arg2 slf4go_api.LogTags, arg3 string, arg4 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2, arg3}
+	for _, a := range arg4 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "LogWithTagsCtxf", varargs...)
+}
+
+// LogWithTagsCtxf indicates an expected call of LogWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) LogWithTagsCtxf(arg0, arg1, arg2, arg3 interface{}, arg4 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2, arg3}, arg4...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).LogWithTagsCtxf), varargs...)
+}
+
 // LogWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) LogWithTagsf(arg0 slf4go_api.LogLevel, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -218,6 +482,57 @@ func (mr *MockSlf4GoLoggerMockRecorder) Logf(arg0, arg1 interface{}, arg2 ...int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logf", reflect.TypeOf((*MockSlf4GoLogger)(nil).Logf), varargs...)
 }
 
+// PanicCtxf mocks base method.
+func (m *MockSlf4GoLogger) PanicCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "PanicCtxf", varargs...)
+}
+
+// PanicCtxf indicates an expected call of PanicCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) PanicCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PanicCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).PanicCtxf), varargs...)
+}
+
+// PanicErrf mocks base method.
+func (m *MockSlf4GoLogger) PanicErrf(arg0 error, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "PanicErrf", varargs...)
+}
+
+// PanicErrf indicates an expected call of PanicErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) PanicErrf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PanicErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).PanicErrf), varargs...)
+}
+
+// PanicWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) PanicWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "PanicWithTagsCtxf", varargs...)
+}
+
+// PanicWithTagsCtxf indicates an expected call of PanicWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) PanicWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PanicWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).PanicWithTagsCtxf), varargs...)
+}
+
 // PanicWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) PanicWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -252,6 +567,40 @@ func (mr *MockSlf4GoLoggerMockRecorder) Panicf(arg0 interface{}, arg1 ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Panicf", reflect.TypeOf((*MockSlf4GoLogger)(nil).Panicf), varargs...)
 }
 
+// TraceCtxf mocks base method.
+func (m *MockSlf4GoLogger) TraceCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "TraceCtxf", varargs...)
+}
+
+// TraceCtxf indicates an expected call of TraceCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) TraceCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).TraceCtxf), varargs...)
+}
+
+// TraceWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) TraceWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "TraceWithTagsCtxf", varargs...)
+}
+
+// TraceWithTagsCtxf indicates an expected call of TraceWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) TraceWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).TraceWithTagsCtxf), varargs...)
+}
+
 // TraceWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) TraceWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -286,6 +635,57 @@ func (mr *MockSlf4GoLoggerMockRecorder) Tracef(arg0 interface{}, arg1 ...interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tracef", reflect.TypeOf((*MockSlf4GoLogger)(nil).Tracef), varargs...)
 }
 
+// WarnCtxf mocks base method.
+func (m *MockSlf4GoLogger) WarnCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarnCtxf", varargs...)
+}
+
+// WarnCtxf indicates an expected call of WarnCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarnCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarnCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarnCtxf), varargs...)
+}
+
+// WarnErrf mocks base method.
+func (m *MockSlf4GoLogger) WarnErrf(arg0 error, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarnErrf", varargs...)
+}
+
+// WarnErrf indicates an expected call of WarnErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarnErrf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarnErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarnErrf), varargs...)
+}
+
+// WarnWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) WarnWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarnWithTagsCtxf", varargs...)
+}
+
+// WarnWithTagsCtxf indicates an expected call of WarnWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarnWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarnWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarnWithTagsCtxf), varargs...)
+}
+
 // WarnWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) WarnWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -320,6 +720,57 @@ func (mr *MockSlf4GoLoggerMockRecorder) Warnf(arg0 interface{}, arg1 ...interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnf", reflect.TypeOf((*MockSlf4GoLogger)(nil).Warnf), varargs...)
 }
 
+// WarningCtxf mocks base method.
+func (m *MockSlf4GoLogger) WarningCtxf(arg0 context.Context, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarningCtxf", varargs...)
+}
+
+// WarningCtxf indicates an expected call of WarningCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarningCtxf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarningCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarningCtxf), varargs...)
+}
+
+// WarningErrf mocks base method.
+func (m *MockSlf4GoLogger) WarningErrf(arg0 error, arg1 string, arg2 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarningErrf", varargs...)
+}
+
+// WarningErrf indicates an expected call of WarningErrf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarningErrf(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarningErrf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarningErrf), varargs...)
+}
+
+// WarningWithTagsCtxf mocks base method.
+func (m *MockSlf4GoLogger) WarningWithTagsCtxf(arg0 context.Context, arg1 slf4go_api.LogTags, arg2 string, arg3 ...interface{}) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "WarningWithTagsCtxf", varargs...)
+}
+
+// WarningWithTagsCtxf indicates an expected call of WarningWithTagsCtxf.
+func (mr *MockSlf4GoLoggerMockRecorder) WarningWithTagsCtxf(arg0, arg1, arg2 interface{}, arg3 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1, arg2}, arg3...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WarningWithTagsCtxf", reflect.TypeOf((*MockSlf4GoLogger)(nil).WarningWithTagsCtxf), varargs...)
+}
+
 // WarningWithTagsf mocks base method.
 func (m *MockSlf4GoLogger) WarningWithTagsf(arg0 slf4go_api.LogTags, arg1 string, arg2 ...interface{}) {
 	m.ctrl.T.Helper()
@@ -368,6 +819,62 @@ func (mr *MockSlf4GoLoggerMockRecorder) WithAppComponentLabel(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithAppComponentLabel", reflect.TypeOf((*MockSlf4GoLogger)(nil).WithAppComponentLabel), arg0)
 }
 
+// WithCallerReporting mocks base method.
+func (m *MockSlf4GoLogger) WithCallerReporting(arg0 bool) slf4go_api.Slf4GoLogger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithCallerReporting", arg0)
+	ret0, _ := ret[0].(slf4go_api.Slf4GoLogger)
+	return ret0
+}
+
+// WithCallerReporting indicates an expected call of WithCallerReporting.
+func (mr *MockSlf4GoLoggerMockRecorder) WithCallerReporting(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithCallerReporting", reflect.TypeOf((*MockSlf4GoLogger)(nil).WithCallerReporting), arg0)
+}
+
+// WithComponentLevels mocks base method.
+func (m *MockSlf4GoLogger) WithComponentLevels(arg0 *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithComponentLevels", arg0)
+	ret0, _ := ret[0].(slf4go_api.Slf4GoLogger)
+	return ret0
+}
+
+// WithComponentLevels indicates an expected call of WithComponentLevels.
+func (mr *MockSlf4GoLoggerMockRecorder) WithComponentLevels(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithComponentLevels", reflect.TypeOf((*MockSlf4GoLogger)(nil).WithComponentLevels), arg0)
+}
+
+// WithError mocks base method.
+func (m *MockSlf4GoLogger) WithError(arg0 error) slf4go_api.Slf4GoLogger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithError", arg0)
+	ret0, _ := ret[0].(slf4go_api.Slf4GoLogger)
+	return ret0
+}
+
+// WithError indicates an expected call of WithError.
+func (mr *MockSlf4GoLoggerMockRecorder) WithError(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithError", reflect.TypeOf((*MockSlf4GoLogger)(nil).WithError), arg0)
+}
+
+// WithStackTrace mocks base method.
+func (m *MockSlf4GoLogger) WithStackTrace(arg0 slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithStackTrace", arg0)
+	ret0, _ := ret[0].(slf4go_api.Slf4GoLogger)
+	return ret0
+}
+
+// WithStackTrace indicates an expected call of WithStackTrace.
+func (mr *MockSlf4GoLoggerMockRecorder) WithStackTrace(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithStackTrace", reflect.TypeOf((*MockSlf4GoLogger)(nil).WithStackTrace), arg0)
+}
+
 // WithStaticTags mocks base method.
 func (m *MockSlf4GoLogger) WithStaticTags(arg0 slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
 	m.ctrl.T.Helper()
@@ -0,0 +1,176 @@
+package slf4go_async
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// record is an entry waiting in the ring buffer. Tags and args are already resolved copies, seq numbers the records
+// in the order they were enqueued, starting at 1.
+type record struct {
+	seq         uint64
+	logger      slf4go_api.Slf4GoLogger
+	level       slf4go_api.LogLevel
+	tags        slf4go_api.LogTags
+	msgTemplate string
+	args        []interface{}
+}
+
+func (r record) write() {
+	r.logger.LogWithTagsf(r.level, r.tags, r.msgTemplate, r.args...)
+}
+
+// writeCtx writes the record with the context it was logged with, if any.
+func (r record) writeCtx(ctx context.Context) {
+	if ctx == nil {
+		r.write()
+		return
+	}
+	r.logger.LogWithTagsCtxf(ctx, r.level, r.tags, r.msgTemplate, r.args...)
+}
+
+// pipeline is the ring buffer shared by an AsyncLogger and all loggers derived from it, along with the
+// goroutine writing the buffered records. changed is signalled on every change of the buffer, the record
+// being written and closed.
+type pipeline struct {
+	mutex      sync.Mutex
+	changed    *sync.Cond
+	buffer     []record
+	head       int
+	size       int
+	seq        uint64
+	writing    bool
+	writingSeq uint64
+	closed     bool
+	done       chan struct{}
+	unregister func()
+	closeOnce  sync.Once
+	dropped    [slf4go_api.Trace + 1]atomic.Uint64
+
+	policy       OverflowPolicy
+	dropLevel    slf4go_api.LogLevel
+	flushTimeout time.Duration
+}
+
+func newPipeline(options ...Option) *pipeline {
+	p := &pipeline{
+		buffer:       make([]record, DefaultBufferSize),
+		done:         make(chan struct{}),
+		policy:       Block,
+		dropLevel:    slf4go_api.Warn,
+		flushTimeout: DefaultFlushTimeout,
+	}
+	p.changed = sync.NewCond(&p.mutex)
+	for _, option := range options {
+		option(p)
+	}
+	p.unregister = slf4go_api.RegisterShutdownHook(p.close)
+	go p.run()
+	return p
+}
+
+// enqueue appends the record to the buffer, applying the overflow policy if the buffer is full. Once the
+// pipeline is closed, the record is written on the calling goroutine instead.
+func (p *pipeline) enqueue(r record) {
+	p.mutex.Lock()
+	for !p.closed && p.size == len(p.buffer) {
+		switch {
+		case p.policy == DropNewest, p.policy == DropBelowLevel && r.level > p.dropLevel:
+			p.mutex.Unlock()
+			p.dropped[r.level].Add(1)
+			return
+		case p.policy == DropOldest:
+			p.dropped[p.pop().level].Add(1)
+		default:
+			p.changed.Wait()
+		}
+	}
+	if p.closed {
+		p.mutex.Unlock()
+		r.write()
+		return
+	}
+	p.seq++
+	r.seq = p.seq
+	p.buffer[(p.head+p.size)%len(p.buffer)] = r
+	p.size++
+	p.changed.Broadcast()
+	p.mutex.Unlock()
+}
+
+// pop removes the oldest record from the buffer. The caller holds the mutex and ensures the buffer is not empty.
+func (p *pipeline) pop() record {
+	r := p.buffer[p.head]
+	p.buffer[p.head] = record{}
+	p.head = (p.head + 1) % len(p.buffer)
+	p.size--
+	p.changed.Broadcast()
+	return r
+}
+
+// run writes the buffered records until the pipeline is closed and the buffer is drained.
+func (p *pipeline) run() {
+	defer close(p.done)
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for {
+		for p.size == 0 && !p.closed {
+			p.changed.Wait()
+		}
+		if p.size == 0 {
+			return
+		}
+		r := p.pop()
+		p.writing, p.writingSeq = true, r.seq
+		p.mutex.Unlock()
+		r.write()
+		p.mutex.Lock()
+		p.writing = false
+		p.changed.Broadcast()
+	}
+}
+
+// flushed reports whether all records up to seq have been written or dropped. The caller holds the mutex.
+func (p *pipeline) flushed(seq uint64) bool {
+	return (p.size == 0 || p.buffer[p.head].seq > seq) && (!p.writing || p.writingSeq > seq)
+}
+
+func (p *pipeline) flush(ctx context.Context) error {
+	stop := context.AfterFunc(ctx, func() {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+		p.changed.Broadcast()
+	})
+	defer stop()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	seq := p.seq
+	for !p.flushed(seq) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p.changed.Wait()
+	}
+	return nil
+}
+
+func (p *pipeline) close(ctx context.Context) error {
+	p.closeOnce.Do(func() {
+		p.mutex.Lock()
+		p.closed = true
+		p.changed.Broadcast()
+		p.mutex.Unlock()
+		p.unregister()
+	})
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,407 @@
+// Package slf4go_async decorates any slf4go_api.Slf4GoLogger with a bounded ring buffer and a background writer,
+// so that slow outputs do not stall the goroutines logging.
+package slf4go_async
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+const (
+	// DefaultBufferSize is the number of entries the ring buffer holds unless changed via WithBufferSize.
+	DefaultBufferSize = 1024
+	// DefaultFlushTimeout bounds the time a Fatal or Panic entry waits for the buffered entries to be written,
+	// unless changed via WithFlushTimeout.
+	DefaultFlushTimeout = 5 * time.Second
+)
+
+// OverflowPolicy decides what happens to an entry logged while the ring buffer is full.
+type OverflowPolicy int
+
+const (
+	// Block waits until the background writer has made room for the entry.
+	Block OverflowPolicy = iota
+	// DropNewest drops the entry being logged.
+	DropNewest
+	// DropOldest drops the oldest buffered entry to make room for the entry being logged.
+	DropOldest
+	// DropBelowLevel drops the entry being logged if it is less severe than the drop level (see WithDropLevel)
+	// and waits like Block otherwise.
+	DropBelowLevel
+)
+
+// Option configures the ring buffer of an AsyncLogger.
+type Option func(pipeline *pipeline)
+
+// WithBufferSize sets the number of entries the ring buffer holds. Sizes below 1 are ignored.
+func WithBufferSize(size int) Option {
+	return func(pipeline *pipeline) {
+		if size > 0 {
+			pipeline.buffer = make([]record, size)
+		}
+	}
+}
+
+// WithOverflowPolicy sets the policy applied to entries logged while the ring buffer is full. The default is Block.
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(pipeline *pipeline) {
+		pipeline.policy = policy
+	}
+}
+
+// WithDropLevel sets the level below which DropBelowLevel drops entries. The default is slf4go_api.Warn, so
+// that warnings and errors are never dropped.
+func WithDropLevel(level slf4go_api.LogLevel) Option {
+	return func(pipeline *pipeline) {
+		pipeline.dropLevel = level
+	}
+}
+
+// WithFlushTimeout sets the time a Fatal or Panic entry waits for the buffered entries to be written.
+func WithFlushTimeout(timeout time.Duration) Option {
+	return func(pipeline *pipeline) {
+		pipeline.flushTimeout = timeout
+	}
+}
+
+// AsyncLogger implements slf4go_api.Slf4GoLogger by passing entries through a bounded ring buffer to a background
+// goroutine writing them to the wrapped logger. Loggers derived from an AsyncLogger share its ring buffer.
+//
+// Level checks, lazy tags and arguments, context tags, caller reporting and stack traces are evaluated on the
+// logging goroutine, so configure caller reporting and stack traces on the AsyncLogger rather than the wrapped
+// logger. Arguments are formatted by the background goroutine and must not be modified after logging. Fatal and
+// Panic entries wait for the buffered entries to be written and are then written on the logging goroutine.
+//
+// New registers Close as a shutdown hook (see slf4go_api.RegisterShutdownHook), so that buffered entries are
+// written before a Fatal entry terminates the program. Entries logged after Close are written synchronously.
+type AsyncLogger struct {
+	target          slf4go_api.Slf4GoLogger
+	pipeline        *pipeline
+	reportCaller    bool
+	stackTraceLevel slf4go_api.LogLevel
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(AsyncLogger{}).PkgPath())
+}
+
+// New creates a new AsyncLogger writing to the given logger and starts its background writer.
+func New(target slf4go_api.Slf4GoLogger, options ...Option) *AsyncLogger {
+	return &AsyncLogger{
+		target:          target,
+		pipeline:        newPipeline(options...),
+		stackTraceLevel: slf4go_api.NoStackTrace,
+	}
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers writing asynchronously to the root logger of the
+// given provider, to be installed via slf4go_api.RegisterProvider.
+func NewProvider(provider slf4go_api.Provider, options ...Option) slf4go_api.Provider {
+	root := New(provider.RootLogger(), options...)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+// Flush waits until all entries logged before the call have been written or dropped, or until ctx is done.
+func (l *AsyncLogger) Flush(ctx context.Context) error {
+	return l.pipeline.flush(ctx)
+}
+
+// Close stops accepting entries into the ring buffer and waits until the background writer has written the
+// buffered entries, or until ctx is done. Close affects all loggers sharing the ring buffer.
+func (l *AsyncLogger) Close(ctx context.Context) error {
+	return l.pipeline.close(ctx)
+}
+
+// Dropped returns the number of entries dropped by the overflow policy so far, across all levels.
+func (l *AsyncLogger) Dropped() uint64 {
+	var dropped uint64
+	for _, level := range slf4go_api.AllLevels {
+		dropped += l.DroppedAt(level)
+	}
+	return dropped
+}
+
+// DroppedAt returns the number of entries with the given level dropped by the overflow policy so far.
+func (l *AsyncLogger) DroppedAt(level slf4go_api.LogLevel) uint64 {
+	if !level.IsValid() {
+		return 0
+	}
+	return l.pipeline.dropped[level].Load()
+}
+
+func (l *AsyncLogger) derive(target slf4go_api.Slf4GoLogger) *AsyncLogger {
+	derived := *l
+	derived.target = target
+	return &derived
+}
+
+func (l *AsyncLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.ForComponent(component))
+}
+
+func (l *AsyncLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithAppComponentLabel(componentTagLabel))
+}
+
+func (l *AsyncLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithStaticTags(tags))
+}
+
+func (l *AsyncLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(slf4go_api.AddStaticTags(l.target, tags))
+}
+
+func (l *AsyncLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithError(err))
+}
+
+// WithCallerReporting is not passed on to the wrapped logger, which would report the background goroutine.
+func (l *AsyncLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	derived := l.derive(l.target)
+	derived.reportCaller = enabled
+	return derived
+}
+
+// WithStackTrace is not passed on to the wrapped logger, which would capture the stack of the background goroutine.
+func (l *AsyncLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	derived := l.derive(l.target)
+	derived.stackTraceLevel = threshold
+	return derived
+}
+
+func (l *AsyncLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithComponentLevels(levels))
+}
+
+func (l *AsyncLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	return l.target.IsEnabled(level)
+}
+
+func (l *AsyncLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *AsyncLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *AsyncLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.log(nil, level, nil, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.log(nil, level, tags, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.log(ctx, level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *AsyncLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.log(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+// log enqueues the entry if its level is enabled. The tags and args are copied, as the caller may change them
+// once log returns, while the entry is still waiting in the buffer. Fatal and Panic entries, as well as entries with unknown levels,
+// are passed on to the wrapped logger on the logging goroutine, after the buffered entries have been written. They are
+// passed on with the context they were logged with, e.g. carrying the scope of slf4go_api.CatchFatal, whose context
+// tags are already part of the given tags.
+func (l *AsyncLogger) log(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	if l.reportCaller {
+		tags = combineTags(slf4go_api.CallerTags(), tags)
+	}
+	if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+		tags = combineTags(slf4go_api.StackTraceTags(), tags)
+	}
+	r := record{
+		logger:      l.target,
+		level:       level,
+		tags:        combineTags(slf4go_api.ResolveTags(tags)),
+		msgTemplate: msgTemplate,
+		args:        append([]interface{}(nil), slf4go_api.ResolveArgs(args)...),
+	}
+	if level > slf4go_api.Panic && level.IsValid() {
+		l.pipeline.enqueue(r)
+		return
+	}
+	flushCtx, cancel := context.WithTimeout(context.Background(), l.pipeline.flushTimeout)
+	defer cancel()
+	_ = l.pipeline.flush(flushCtx)
+	r.writeCtx(ctx)
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *AsyncLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *AsyncLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
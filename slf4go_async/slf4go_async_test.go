@@ -0,0 +1,349 @@
+package slf4go_async_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_async"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+	"github.com/MariusSchmidt/slf4go/slf4go_test"
+)
+
+// The tests live in an external test package, as frames of the slf4go_async package itself are skipped when
+// determining the call site.
+
+// gatedLogger blocks writing each entry until it is released, announcing the entry waiting via entered.
+type gatedLogger struct {
+	slf4go_api.Slf4GoLogger
+	entered chan string
+	release chan struct{}
+}
+
+func newGatedLogger(target slf4go_api.Slf4GoLogger) *gatedLogger {
+	return &gatedLogger{Slf4GoLogger: target, entered: make(chan string, 100), release: make(chan struct{})}
+}
+
+func (l *gatedLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.entered <- fmt.Sprintf(msgTemplate, args...)
+	<-l.release
+	l.Slf4GoLogger.LogWithTagsf(level, tags, msgTemplate, args...)
+}
+
+func messages(recorder *slf4go_test.RecordingLogger) []string {
+	var messages []string
+	for _, entry := range recorder.Entries() {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
+
+func closeLogger(t *testing.T, logger *slf4go_async.AsyncLogger) {
+	require.NoError(t, logger.Close(context.Background()))
+}
+
+func TestAsyncLogger_WritesEntries(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_async.New(recorder)
+	defer closeLogger(t, logger)
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"requestId": "r-1"})
+	err := errors.New("card declined")
+
+	logger.Infof("server started on port %d", 8080)
+	logger.ForComponent("billing").WithStaticTags(slf4go_api.LogTags{"service": "billing"}).
+		InfoWithTagsCtxf(ctx, slf4go_api.LogTags{"orderId": slf4go_api.Lazy(func() interface{} { return 42 })}, "charging order")
+	logger.WarnErrf(err, "retrying order %v", slf4go_api.Lazy(func() interface{} { return 42 }))
+	logger.Debugf("debug entry")
+	require.NoError(t, logger.Flush(context.Background()))
+
+	slf4go_test.AssertLog(t, recorder).
+		HasCount(4).
+		HasEntriesInOrder(
+			slf4go_test.WithMessage("server started on port 8080"),
+			slf4go_test.WithTags(slf4go_api.LogTags{"orderId": 42, "requestId": "r-1", "service": "billing"}),
+			slf4go_test.WithMessage("retrying order 42"),
+			slf4go_test.AtLevel(slf4go_api.Debug)).
+		Entry(1).HasComponent("billing")
+	slf4go_test.AssertLog(t, recorder).Entry(2).HasError(err)
+}
+
+func TestAsyncLogger_Levels(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_async.New(recorder).WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Info))
+	defer closeLogger(t, logger.(*slf4go_async.AsyncLogger))
+
+	assert.False(t, logger.IsDebugEnabled())
+	assert.True(t, logger.IsEnabled(slf4go_api.Info))
+	logger.Debugf("discarded")
+	logger.Infof("written")
+	require.NoError(t, logger.(*slf4go_async.AsyncLogger).Flush(context.Background()))
+
+	assert.Equal(t, []string{"written"}, messages(recorder))
+}
+
+func TestAsyncLogger_DisabledWithoutExtractingContextTags(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_async.New(recorder).WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Info))
+	defer closeLogger(t, logger.(*slf4go_async.AsyncLogger))
+	extractions := 0
+	unregister := slf4go_api.RegisterContextTagExtractor(func(ctx context.Context) slf4go_api.LogTags {
+		extractions++
+		return nil
+	})
+	defer unregister()
+
+	logger.DebugCtxf(context.Background(), "discarded")
+	logger.DebugWithTagsCtxf(context.Background(), slf4go_api.LogTags{"key1": "val1"}, "discarded")
+	require.NoError(t, logger.(*slf4go_async.AsyncLogger).Flush(context.Background()))
+
+	assert.Equal(t, 0, extractions)
+	assert.Empty(t, messages(recorder))
+}
+
+func TestAsyncLogger_OverflowPolicies(t *testing.T) {
+	scenarios := []struct {
+		name            string
+		options         []slf4go_async.Option
+		overflow        func(logger slf4go_api.Slf4GoLogger)
+		expected        []string
+		expectedDropped map[slf4go_api.LogLevel]uint64
+	}{
+		{
+			name:            "drop-newest",
+			options:         []slf4go_async.Option{slf4go_async.WithOverflowPolicy(slf4go_async.DropNewest)},
+			overflow:        func(logger slf4go_api.Slf4GoLogger) { logger.Infof("4") },
+			expected:        []string{"1", "2", "3"},
+			expectedDropped: map[slf4go_api.LogLevel]uint64{slf4go_api.Info: 1},
+		},
+		{
+			name:            "drop-oldest",
+			options:         []slf4go_async.Option{slf4go_async.WithOverflowPolicy(slf4go_async.DropOldest)},
+			overflow:        func(logger slf4go_api.Slf4GoLogger) { logger.Infof("4"); logger.Errorf("5") },
+			expected:        []string{"1", "4", "5"},
+			expectedDropped: map[slf4go_api.LogLevel]uint64{slf4go_api.Debug: 1, slf4go_api.Info: 1},
+		},
+		{
+			name:            "drop-below-level",
+			options:         []slf4go_async.Option{slf4go_async.WithOverflowPolicy(slf4go_async.DropBelowLevel)},
+			overflow:        func(logger slf4go_api.Slf4GoLogger) { logger.Infof("4"); logger.Debugf("5") },
+			expected:        []string{"1", "2", "3"},
+			expectedDropped: map[slf4go_api.LogLevel]uint64{slf4go_api.Info: 1, slf4go_api.Debug: 1},
+		},
+		{
+			name: "drop-below-custom-level",
+			options: []slf4go_async.Option{
+				slf4go_async.WithOverflowPolicy(slf4go_async.DropBelowLevel),
+				slf4go_async.WithDropLevel(slf4go_api.Error),
+			},
+			overflow:        func(logger slf4go_api.Slf4GoLogger) { logger.Warnf("4") },
+			expected:        []string{"1", "2", "3"},
+			expectedDropped: map[slf4go_api.LogLevel]uint64{slf4go_api.Warn: 1},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			recorder := slf4go_test.NewRecordingLogger()
+			gate := newGatedLogger(recorder)
+			logger := slf4go_async.New(gate, append(scenario.options, slf4go_async.WithBufferSize(2))...)
+			defer closeLogger(t, logger)
+
+			logger.Infof("1")
+			assert.Equal(t, "1", <-gate.entered)
+			logger.Debugf("2")
+			logger.Infof("3")
+			scenario.overflow(logger)
+			close(gate.release)
+			require.NoError(t, logger.Flush(context.Background()))
+
+			assert.Equal(t, scenario.expected, messages(recorder))
+			var total uint64
+			for _, level := range slf4go_api.AllLevels {
+				assert.Equal(t, scenario.expectedDropped[level], logger.DroppedAt(level), "dropped at %s", level)
+				total += scenario.expectedDropped[level]
+			}
+			assert.Equal(t, total, logger.Dropped())
+		})
+	}
+}
+
+func TestAsyncLogger_CopiesTagsAndArgs(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_async.New(recorder)
+	defer closeLogger(t, logger)
+
+	for i := 0; i < 100; i++ {
+		tags := slf4go_api.LogTags{"orderId": i}
+		args := []interface{}{i}
+		logger.InfoWithTagsf(tags, "charging order %d", args...)
+		tags["orderId"] = -1
+		tags["status"] = "charged"
+		args[0] = -1
+	}
+	require.NoError(t, logger.Flush(context.Background()))
+
+	require.Len(t, recorder.Entries(), 100)
+	for i, entry := range recorder.Entries() {
+		assert.Equal(t, slf4go_api.LogTags{"orderId": i}, entry.Tags)
+		assert.Equal(t, fmt.Sprintf("charging order %d", i), entry.Message)
+	}
+}
+
+func TestAsyncLogger_Block(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	gate := newGatedLogger(recorder)
+	logger := slf4go_async.New(gate, slf4go_async.WithBufferSize(1))
+	defer closeLogger(t, logger)
+
+	logger.Infof("1")
+	assert.Equal(t, "1", <-gate.entered)
+	logger.Infof("2")
+	logged := make(chan struct{})
+	go func() {
+		defer close(logged)
+		logger.Infof("3")
+	}()
+
+	select {
+	case <-logged:
+		t.Fatal("entry logged into a full buffer should block")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(gate.release)
+	<-logged
+	require.NoError(t, logger.Flush(context.Background()))
+
+	assert.Equal(t, []string{"1", "2", "3"}, messages(recorder))
+	assert.Zero(t, logger.Dropped())
+}
+
+func TestAsyncLogger_FlushTimeout(t *testing.T) {
+	gate := newGatedLogger(slf4go_test.NewRecordingLogger())
+	logger := slf4go_async.New(gate)
+	defer closeLogger(t, logger)
+	defer close(gate.release)
+
+	logger.Infof("1")
+	<-gate.entered
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, logger.Flush(ctx), context.DeadlineExceeded)
+}
+
+func TestAsyncLogger_FatalAndPanic(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_async.New(recorder)
+	defer closeLogger(t, logger)
+
+	for i := 0; i < 100; i++ {
+		logger.Infof("entry %d", i)
+	}
+	assert.PanicsWithValue(t, "inconsistent state", func() { logger.Panicf("inconsistent state") })
+	assert.PanicsWithValue(t, slf4go_test.ErrFatal, func() { logger.Fatalf("disk full") })
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 102)
+	assert.Equal(t, "entry 99", entries[99].Message)
+	assert.Equal(t, slf4go_api.Panic, entries[100].Level)
+	assert.Equal(t, slf4go_api.Fatal, entries[101].Level)
+}
+
+func TestAsyncLogger_CatchFatal(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slf4go_async.New(slf4go_native_provider.New(output))
+	defer closeLogger(t, logger)
+
+	err := slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+		logger.Infof("started")
+		logger.ForComponent("billing").Fatalf("disk full")
+	})
+
+	assert.Equal(t, &slf4go_api.FatalError{Message: "disk full", Code: slf4go_api.DefaultExitCode}, err)
+	assert.Contains(t, output.String(), "level=info msg=started\n")
+	assert.Contains(t, output.String(), "level=fatal msg=\"disk full\" appComponent=billing\n")
+}
+
+func TestAsyncLogger_Close(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	gate := newGatedLogger(recorder)
+	logger := slf4go_async.New(gate)
+
+	logger.Infof("1")
+	logger.Infof("2")
+	<-gate.entered
+	closed := make(chan error)
+	go func() {
+		closed <- logger.Close(context.Background())
+	}()
+	close(gate.release)
+
+	require.NoError(t, <-closed)
+	assert.Equal(t, []string{"1", "2"}, messages(recorder))
+	logger.Infof("3")
+	assert.Equal(t, []string{"1", "2", "3"}, messages(recorder), "entries after Close are written synchronously")
+	assert.NoError(t, logger.Close(context.Background()))
+}
+
+func TestAsyncLogger_ClosedOnShutdown(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	gate := newGatedLogger(recorder)
+	logger := slf4go_async.New(gate)
+	close(gate.release)
+
+	logger.Infof("1")
+	require.NoError(t, slf4go_api.Shutdown(context.Background()))
+
+	assert.Equal(t, []string{"1"}, messages(recorder))
+	logger.Infof("2")
+	assert.Equal(t, []string{"1", "2"}, messages(recorder))
+}
+
+func TestAsyncLogger_CallerReporting(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	root := slf4go_async.New(recorder)
+	defer closeLogger(t, root)
+	logger := root.WithCallerReporting(true).WithStackTrace(slf4go_api.Error)
+
+	logger.Errorf("test message")
+	line := currentLine() - 1
+	require.NoError(t, root.Flush(context.Background()))
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasSuffix(fmt.Sprint(entries[0].Tags[slf4go_api.CallerTag]), fmt.Sprintf("/slf4go_async_test.go:%d", line)),
+		"unexpected caller %v", entries[0].Tags[slf4go_api.CallerTag])
+	stackTrace, ok := entries[0].Tags[slf4go_api.StackTraceTag].(slf4go_api.StackTrace)
+	if assert.True(t, ok) && assert.NotEmpty(t, stackTrace) {
+		assert.True(t, strings.HasPrefix(stackTrace[0].Function, "github.com/MariusSchmidt/slf4go/slf4go_async_test.TestAsyncLogger_CallerReporting"),
+			"unexpected frame %v", stackTrace[0])
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	provider := slf4go_async.NewProvider(slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return recorder
+	}))
+
+	root := provider.RootLogger()
+	assert.Same(t, root, provider.RootLogger())
+	root.ForComponent("billing").Infof("charging order")
+	require.NoError(t, root.(*slf4go_async.AsyncLogger).Close(context.Background()))
+
+	slf4go_test.AssertLog(t, recorder).HasCount(1).Last().HasComponent("billing").HasMessage("charging order")
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
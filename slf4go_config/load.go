@@ -0,0 +1,147 @@
+package slf4go_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Suffixes of the environment variables read by FromEnv, appended to the prefix passed to it.
+const (
+	// EnvLevel holds Config.Level, e.g. LOG_LEVEL=debug.
+	EnvLevel = "LEVEL"
+	// EnvComponentLevels holds Config.ComponentLevels, e.g. LOG_COMPONENT_LEVELS=payment-gateway=trace,billing=warn.
+	EnvComponentLevels = "COMPONENT_LEVELS"
+	// EnvProvider holds Config.Provider, e.g. LOG_PROVIDER=slog.
+	EnvProvider = "PROVIDER"
+	// EnvFormat holds Config.Format, e.g. LOG_FORMAT=json.
+	EnvFormat = "FORMAT"
+	// EnvTags holds Config.Tags, e.g. LOG_TAGS=service=billing,region=eu-west-1.
+	EnvTags = "TAGS"
+	// EnvComponentLabel holds Config.ComponentLabel, e.g. LOG_COMPONENT_LABEL=component.
+	EnvComponentLabel = "COMPONENT_LABEL"
+	// EnvRedact holds the keys of Config.Redact, e.g. LOG_REDACT=password,authorization.
+	EnvRedact = "REDACT"
+	// EnvOutput holds Config.Output, e.g. LOG_OUTPUT=/var/log/billing.log.
+	EnvOutput = "OUTPUT"
+)
+
+// FromEnv reads the config from the environment variables with the given prefix, e.g. "LOG_" for LOG_LEVEL.
+// Lists are comma-separated, maps are comma-separated key=value pairs. Unset variables leave the defaults.
+// The config is validated, all problems are reported at once.
+func FromEnv(prefix string) (Config, error) {
+	var config Config
+	var errs []error
+	config.Level = os.Getenv(prefix + EnvLevel)
+	config.Provider = os.Getenv(prefix + EnvProvider)
+	config.Format = os.Getenv(prefix + EnvFormat)
+	config.ComponentLabel = os.Getenv(prefix + EnvComponentLabel)
+	config.Output = os.Getenv(prefix + EnvOutput)
+	if value, ok := os.LookupEnv(prefix + EnvComponentLevels); ok {
+		pairs, err := parsePairs(prefix+EnvComponentLevels, value)
+		errs = append(errs, err)
+		config.ComponentLevels = make(map[slf4go_api.AppComponent]string, len(pairs))
+		for key, level := range pairs {
+			config.ComponentLevels[slf4go_api.AppComponent(key)] = level
+		}
+	}
+	if value, ok := os.LookupEnv(prefix + EnvTags); ok {
+		pairs, err := parsePairs(prefix+EnvTags, value)
+		errs = append(errs, err)
+		config.Tags = make(slf4go_api.LogTags, len(pairs))
+		for key, tag := range pairs {
+			config.Tags[key] = tag
+		}
+	}
+	for _, key := range splitList(os.Getenv(prefix + EnvRedact)) {
+		config.Redact = append(config.Redact, RedactionRule{Key: key})
+	}
+	return config, errors.Join(append(errs, config.Validate())...)
+}
+
+// FromJSON reads the config from a JSON document. Unknown fields are rejected.
+// The config is validated, all problems are reported at once.
+func FromJSON(data []byte) (Config, error) {
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return Config{}, fmt.Errorf("invalid JSON config: %w", err)
+	}
+	return config, config.Validate()
+}
+
+// FromYAML reads the config from a YAML document. Unknown fields are rejected.
+// The config is validated, all problems are reported at once.
+func FromYAML(data []byte) (Config, error) {
+	var config Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return Config{}, fmt.Errorf("invalid YAML config: %w", err)
+	}
+	return config, config.Validate()
+}
+
+// LoadFile reads the config from the given file, as JSON for the extension .json and as YAML for .yaml and .yml.
+func LoadFile(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FromJSON(data)
+	case ".yaml", ".yml":
+		return FromYAML(data)
+	default:
+		return Config{}, fmt.Errorf("unsupported config file '%s', expected extension .json, .yaml or .yml", path)
+	}
+}
+
+// parsePairs parses comma-separated key=value pairs, reporting all malformed pairs.
+func parsePairs(variable string, value string) (map[string]string, error) {
+	pairs := make(map[string]string)
+	var errs []error
+	for _, pair := range splitList(value) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: expected key=value, got '%s'", variable, pair))
+			continue
+		}
+		pairs[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return pairs, errors.Join(errs...)
+}
+
+// splitList splits a comma-separated list, dropping empty elements.
+func splitList(value string) []string {
+	var elements []string
+	for _, element := range strings.Split(value, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return elements
+}
+
+func sortedComponents(levels map[slf4go_api.AppComponent]string) []slf4go_api.AppComponent {
+	components := make([]slf4go_api.AppComponent, 0, len(levels))
+	for component := range levels {
+		components = append(components, component)
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i] < components[j]
+	})
+	return components
+}
@@ -0,0 +1,147 @@
+package slf4go_config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+var expectedConfig = Config{
+	Level:           "debug",
+	ComponentLevels: map[slf4go_api.AppComponent]string{"payment-gateway": "trace", "billing": "warn"},
+	Provider:        "slog",
+	Format:          "json",
+	Tags:            slf4go_api.LogTags{"service": "billing", "region": "eu-west-1"},
+	ComponentLabel:  "component",
+	Redact:          []RedactionRule{{Key: "password"}, {Key: "authorization"}},
+	Output:          "stderr",
+}
+
+const jsonConfig = `{
+	"level": "debug",
+	"componentLevels": {"payment-gateway": "trace", "billing": "warn"},
+	"provider": "slog",
+	"format": "json",
+	"tags": {"service": "billing", "region": "eu-west-1"},
+	"componentLabel": "component",
+	"redact": [{"key": "password"}, {"key": "authorization"}],
+	"output": "stderr"
+}`
+
+const yamlConfig = `
+level: debug
+componentLevels:
+  payment-gateway: trace
+  billing: warn
+provider: slog
+format: json
+tags:
+  service: billing
+  region: eu-west-1
+componentLabel: component
+redact:
+  - key: password
+  - key: authorization
+output: stderr
+`
+
+func TestFromEnv(t *testing.T) {
+	t.Run("all-variables", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "debug")
+		t.Setenv("LOG_COMPONENT_LEVELS", "payment-gateway=trace, billing=warn")
+		t.Setenv("LOG_PROVIDER", "slog")
+		t.Setenv("LOG_FORMAT", "json")
+		t.Setenv("LOG_TAGS", "service=billing,region=eu-west-1")
+		t.Setenv("LOG_COMPONENT_LABEL", "component")
+		t.Setenv("LOG_REDACT", "password,authorization")
+		t.Setenv("LOG_OUTPUT", "stderr")
+
+		config, err := FromEnv("LOG_")
+
+		require.NoError(t, err)
+		assert.Equal(t, expectedConfig, config)
+	})
+
+	t.Run("no-variables", func(t *testing.T) {
+		config, err := FromEnv("UNSET_")
+
+		require.NoError(t, err)
+		assert.Equal(t, Config{}, config)
+	})
+
+	t.Run("reports-all-errors", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "verbose")
+		t.Setenv("LOG_COMPONENT_LEVELS", "payment-gateway")
+		t.Setenv("LOG_TAGS", "service")
+
+		_, err := FromEnv("LOG_")
+
+		assert.EqualError(t, err, "LOG_COMPONENT_LEVELS: expected key=value, got 'payment-gateway'\n"+
+			"LOG_TAGS: expected key=value, got 'service'\n"+
+			"level: unknown log level 'verbose'")
+	})
+}
+
+func TestFromJSON(t *testing.T) {
+	config, err := FromJSON([]byte(jsonConfig))
+	require.NoError(t, err)
+	assert.Equal(t, expectedConfig, config)
+
+	_, err = FromJSON([]byte(`{"level": "verbose", "format": "xml"}`))
+	assert.EqualError(t, err, "level: unknown log level 'verbose'\nformat: unknown format 'xml', expected 'text' or 'json'")
+
+	_, err = FromJSON([]byte(`{"levle": "debug"}`))
+	assert.ErrorContains(t, err, "invalid JSON config")
+}
+
+func TestFromYAML(t *testing.T) {
+	config, err := FromYAML([]byte(yamlConfig))
+	require.NoError(t, err)
+	assert.Equal(t, expectedConfig, config)
+
+	config, err = FromYAML([]byte(""))
+	require.NoError(t, err)
+	assert.Equal(t, Config{}, config)
+
+	_, err = FromYAML([]byte("level: verbose\nformat: xml\n"))
+	assert.EqualError(t, err, "level: unknown log level 'verbose'\nformat: unknown format 'xml', expected 'text' or 'json'")
+
+	_, err = FromYAML([]byte("levle: debug\n"))
+	assert.ErrorContains(t, err, "invalid YAML config")
+}
+
+func TestLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"logging.json": jsonConfig,
+		"logging.yaml": yamlConfig,
+		"logging.yml":  yamlConfig,
+		"logging.toml": "",
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	for _, name := range []string{"logging.json", "logging.yaml", "logging.yml"} {
+		t.Run(name, func(t *testing.T) {
+			config, err := LoadFile(filepath.Join(dir, name))
+			require.NoError(t, err)
+			assert.Equal(t, expectedConfig, config)
+		})
+	}
+
+	t.Run("unsupported-extension", func(t *testing.T) {
+		_, err := LoadFile(filepath.Join(dir, "logging.toml"))
+		assert.ErrorContains(t, err, "unsupported config file")
+	})
+
+	t.Run("missing-file", func(t *testing.T) {
+		_, err := LoadFile(filepath.Join(dir, "missing.json"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
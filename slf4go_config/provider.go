@@ -0,0 +1,84 @@
+package slf4go_config
+
+import (
+	"io"
+	"log/slog"
+	"sort"
+	"sync"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+	"github.com/MariusSchmidt/slf4go/slf4go_slog_provider"
+)
+
+// Names of the providers available by default.
+const (
+	// ProviderNative builds loggers via slf4go_native_provider. It is the default provider.
+	ProviderNative = "native"
+	// ProviderSlog builds loggers via slf4go_slog_provider, writing through slog.TextHandler or slog.JSONHandler.
+	ProviderSlog = "slog"
+)
+
+// ProviderFactory builds the backend logger of a config writing entries in the given format, FormatText or FormatJSON,
+// to writer. The logger must write all levels, the configured levels, component label, static tags and redaction
+// rules are applied onto it.
+type ProviderFactory func(writer io.Writer, format string) slf4go_api.Slf4GoLogger
+
+var (
+	providersMutex sync.RWMutex
+	providers      = map[string]ProviderFactory{
+		ProviderNative: nativeProvider,
+		ProviderSlog:   slogProvider,
+	}
+)
+
+// RegisterProviderFactory makes a provider available to configs under the given name, e.g. to build loggers via
+// slf4go_zap_provider for configs with "provider: zap". Registering a name again replaces its factory.
+func RegisterProviderFactory(name string, factory ProviderFactory) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+	providers[name] = factory
+}
+
+// providerFactory returns the factory registered under the given name, ProviderNative for the empty name.
+func providerFactory(name string) (ProviderFactory, bool) {
+	if name == "" {
+		name = ProviderNative
+	}
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	factory, ok := providers[name]
+	return factory, ok
+}
+
+// providerNames returns the names of all registered providers, sorted.
+func providerNames() []string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func nativeProvider(writer io.Writer, format string) slf4go_api.Slf4GoLogger {
+	var encoder slf4go_native_provider.Encoder = slf4go_native_provider.TextEncoder{}
+	if format == FormatJSON {
+		encoder = slf4go_native_provider.JSONEncoder{}
+	}
+	return slf4go_native_provider.New(writer,
+		slf4go_native_provider.WithEncoder(encoder),
+		slf4go_native_provider.WithMinLevel(slf4go_api.Trace),
+	)
+}
+
+func slogProvider(writer io.Writer, format string) slf4go_api.Slf4GoLogger {
+	options := &slog.HandlerOptions{Level: slf4go_slog_provider.LevelTrace, ReplaceAttr: slf4go_slog_provider.ReplaceLevelAttr}
+	var handler slog.Handler = slog.NewTextHandler(writer, options)
+	if format == FormatJSON {
+		handler = slog.NewJSONHandler(writer, options)
+	}
+	return slf4go_slog_provider.New(slog.New(handler))
+}
@@ -0,0 +1,204 @@
+package slf4go_config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_redact"
+)
+
+// Output formats of the loggers built from a Config.
+const (
+	// FormatText writes entries in logfmt (see slf4go_native_provider.TextEncoder). It is the default format.
+	FormatText = "text"
+	// FormatJSON writes entries as JSON objects (see slf4go_native_provider.JSONEncoder).
+	FormatJSON = "json"
+)
+
+// Outputs besides file paths that entries can be written to.
+const (
+	// OutputStdout writes entries to the standard output. It is the default output.
+	OutputStdout = "stdout"
+	// OutputStderr writes entries to the standard error.
+	OutputStderr = "stderr"
+)
+
+// DefaultRedactionReplacement replaces the values of redacted tags unless a RedactionRule specifies a replacement.
+const DefaultRedactionReplacement = slf4go_api.RedactedPlaceholder
+
+// Config is the declarative logging configuration of a service. It can be read from environment variables
+// (FromEnv) or from JSON and YAML documents (FromJSON, FromYAML, LoadFile). Levels are kept as names,
+// so that Validate can report all invalid entries at once.
+type Config struct {
+	// Level is the level of all components without override. Defaults to info.
+	Level string `json:"level" yaml:"level"`
+	// ComponentLevels overrides the level of components and, hierarchically, of their child components.
+	ComponentLevels map[slf4go_api.AppComponent]string `json:"componentLevels" yaml:"componentLevels"`
+	// Provider is the name of the provider building the loggers, see RegisterProviderFactory. Defaults to ProviderNative.
+	Provider string `json:"provider" yaml:"provider"`
+	// Format is the output format, FormatText or FormatJSON. Defaults to FormatText.
+	Format string `json:"format" yaml:"format"`
+	// Tags are added as static tags to all entries, also of loggers setting static tags of their own.
+	Tags slf4go_api.LogTags `json:"tags" yaml:"tags"`
+	// ComponentLabel is the key components are logged under. Defaults to slf4go_api.DefaultAppComponentTag.
+	ComponentLabel string `json:"componentLabel" yaml:"componentLabel"`
+	// Redact lists the tags, map entries and struct fields whose values must not be written.
+	Redact []RedactionRule `json:"redact" yaml:"redact"`
+	// Output is where entries are written to: OutputStdout, OutputStderr or the path of a file entries are appended to.
+	// Defaults to OutputStdout. Build writes to the writer passed to it, OpenOutput and Watch open the configured output.
+	Output string `json:"output" yaml:"output"`
+}
+
+// RedactionRule replaces the value of all tags, map entries and struct fields with the given key, compared
+// case-insensitively. Keys may contain the wildcards of path.Match, e.g. "*token*".
+type RedactionRule struct {
+	Key string `json:"key" yaml:"key"`
+	// Replacement is written instead of the value. Defaults to DefaultRedactionReplacement.
+	Replacement string `json:"replacement" yaml:"replacement"`
+}
+
+// Logging is the result of building a Config.
+type Logging struct {
+	// Logger is the root logger writing all entries.
+	Logger slf4go_api.Slf4GoLogger
+	// Levels holds the configured levels. Changing them, e.g. via slf4go_admin, applies to Logger and all loggers derived from it.
+	Levels *slf4go_api.ComponentLevels
+}
+
+// Provider returns a slf4go_api.Provider handing out Logger, to be installed via slf4go_api.RegisterProvider.
+func (l *Logging) Provider() slf4go_api.Provider {
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return l.Logger
+	})
+}
+
+// Validate checks the config and returns all problems found, joined via errors.Join, or nil.
+func (c Config) Validate() error {
+	var errs []error
+	if c.Level != "" {
+		if _, err := slf4go_api.ParseLevel(c.Level); err != nil {
+			errs = append(errs, fmt.Errorf("level: %w", err))
+		}
+	}
+	for _, component := range sortedComponents(c.ComponentLevels) {
+		if strings.TrimSpace(string(component)) == "" {
+			errs = append(errs, errors.New("componentLevels: empty component"))
+			continue
+		}
+		if _, err := slf4go_api.ParseLevel(c.ComponentLevels[component]); err != nil {
+			errs = append(errs, fmt.Errorf("componentLevels.%s: %w", component, err))
+		}
+	}
+	if _, ok := providerFactory(c.Provider); !ok {
+		errs = append(errs, fmt.Errorf("provider: unknown provider '%s', expected one of '%s'", c.Provider, strings.Join(providerNames(), "', '")))
+	}
+	switch strings.ToLower(c.Format) {
+	case "", FormatText, FormatJSON:
+	default:
+		errs = append(errs, fmt.Errorf("format: unknown format '%s', expected '%s' or '%s'", c.Format, FormatText, FormatJSON))
+	}
+	for key := range c.Tags {
+		if strings.TrimSpace(key) == "" {
+			errs = append(errs, errors.New("tags: empty key"))
+		}
+	}
+	redacted := make(map[string]bool)
+	for i, rule := range c.Redact {
+		key := strings.ToLower(strings.TrimSpace(rule.Key))
+		switch {
+		case key == "":
+			errs = append(errs, fmt.Errorf("redact[%d]: empty key", i))
+		case redacted[key]:
+			errs = append(errs, fmt.Errorf("redact[%d]: duplicate key '%s'", i, rule.Key))
+		default:
+			if _, err := path.Match(key, ""); err != nil {
+				errs = append(errs, fmt.Errorf("redact[%d]: malformed key pattern '%s'", i, rule.Key))
+			}
+		}
+		redacted[key] = true
+	}
+	return errors.Join(errs...)
+}
+
+// Build validates the config and builds a root logger writing to writer via the configured provider.
+// The backend writes all levels, the configured levels are applied via slf4go_api.ComponentLevels.
+func (c Config) Build(writer io.Writer) (*Logging, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c.build(writer), nil
+}
+
+// OpenOutput opens the configured Output. Closing the standard output or standard error is a no-op.
+func (c Config) OpenOutput() (io.WriteCloser, error) {
+	switch c.Output {
+	case "", OutputStdout:
+		return nopCloser{os.Stdout}, nil
+	case OutputStderr:
+		return nopCloser{os.Stderr}, nil
+	default:
+		return os.OpenFile(c.Output, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// build builds the logging of a valid config with new levels.
+func (c Config) build(writer io.Writer) *Logging {
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	c.applyLevels(levels)
+	return &Logging{Logger: c.logger(writer, levels), Levels: levels}
+}
+
+// logger builds the root logger of a valid config consulting the given levels.
+func (c Config) logger(writer io.Writer, levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	factory, _ := providerFactory(c.Provider)
+	logger := factory(writer, strings.ToLower(c.Format)).WithComponentLevels(levels)
+	if len(c.Redact) > 0 {
+		logger = slf4go_redact.New(logger, c.redactor())
+	}
+	if c.ComponentLabel != "" {
+		logger = logger.WithAppComponentLabel(c.ComponentLabel)
+	}
+	if len(c.Tags) > 0 {
+		logger = newTagsLogger(logger, c.Tags)
+	}
+	return logger
+}
+
+// applyLevels replaces the default level and all overrides of the given levels with those of a valid config.
+func (c Config) applyLevels(levels *slf4go_api.ComponentLevels) {
+	defaultLevel := slf4go_api.Info
+	if c.Level != "" {
+		defaultLevel, _ = slf4go_api.ParseLevel(c.Level)
+	}
+	overrides := make(map[slf4go_api.AppComponent]slf4go_api.LogLevel, len(c.ComponentLevels))
+	for component, name := range c.ComponentLevels {
+		overrides[component], _ = slf4go_api.ParseLevel(name)
+	}
+	levels.Replace(defaultLevel, overrides)
+}
+
+// redactor returns the redactor masking the values of the keys of a valid config.
+func (c Config) redactor() *slf4go_redact.Redactor {
+	var options []slf4go_redact.Option
+	for _, rule := range c.Redact {
+		replacement := rule.Replacement
+		if replacement == "" {
+			replacement = DefaultRedactionReplacement
+		}
+		options = append(options, slf4go_redact.RedactKeys(slf4go_redact.Replace(replacement), strings.TrimSpace(rule.Key)))
+	}
+	return slf4go_redact.NewRedactor(options...)
+}
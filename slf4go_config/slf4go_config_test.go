@@ -0,0 +1,215 @@
+package slf4go_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_test"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		config := Config{
+			Level:           "DEBUG",
+			ComponentLevels: map[slf4go_api.AppComponent]string{"payment-gateway": "trace"},
+			Format:          "JSON",
+			Tags:            slf4go_api.LogTags{"service": "billing"},
+			Redact:          []RedactionRule{{Key: "password"}},
+		}
+		assert.NoError(t, config.Validate())
+		assert.NoError(t, Config{}.Validate())
+	})
+
+	t.Run("reports-all-errors", func(t *testing.T) {
+		config := Config{
+			Level:           "verbose",
+			ComponentLevels: map[slf4go_api.AppComponent]string{"payment-gateway": "loud", "": "info"},
+			Provider:        "log4j",
+			Format:          "xml",
+			Tags:            slf4go_api.LogTags{" ": "value"},
+			Redact:          []RedactionRule{{Key: "password"}, {Key: ""}, {Key: "Password"}, {Key: "[token"}},
+		}
+
+		err := config.Validate()
+
+		assert.ErrorIs(t, err, slf4go_api.ErrUnknownLevel)
+		assert.Equal(t, []string{
+			"level: unknown log level 'verbose'",
+			"componentLevels: empty component",
+			"componentLevels.payment-gateway: unknown log level 'loud'",
+			"provider: unknown provider 'log4j', expected one of 'native', 'slog'",
+			"format: unknown format 'xml', expected 'text' or 'json'",
+			"tags: empty key",
+			"redact[1]: empty key",
+			"redact[2]: duplicate key 'Password'",
+			"redact[3]: malformed key pattern '[token'",
+		}, strings.Split(err.Error(), "\n"))
+	})
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("invalid-config", func(t *testing.T) {
+		logging, err := Config{Level: "verbose"}.Build(&bytes.Buffer{})
+		assert.ErrorIs(t, err, slf4go_api.ErrUnknownLevel)
+		assert.Nil(t, logging)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{}.Build(&buffer)
+		require.NoError(t, err)
+
+		logging.Logger.Debugf("discarded")
+		logging.Logger.ForComponent("billing").Infof("written")
+
+		assert.Equal(t, slf4go_api.Info, logging.Levels.DefaultLevel())
+		assert.NotContains(t, buffer.String(), "discarded")
+		assert.Contains(t, buffer.String(), `level=info msg=written appComponent=billing`)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{
+			Level:           "warn",
+			ComponentLevels: map[slf4go_api.AppComponent]string{"payment-gateway": "trace"},
+			Format:          FormatJSON,
+			Tags:            slf4go_api.LogTags{"service": "billing", "apiToken": "t0k3n"},
+			ComponentLabel:  "component",
+			Redact:          []RedactionRule{{Key: "password"}, {Key: "Authorization", Replacement: "***"}, {Key: "*token*"}},
+		}.Build(&buffer)
+		require.NoError(t, err)
+
+		logging.Logger.ForComponent("user-service").Infof("discarded")
+		logging.Logger.ForComponent("payment-gateway.client").TraceWithTagsf(slf4go_api.LogTags{
+			"Password":      "secret",
+			"authorization": "Bearer token",
+			"user":          "alice",
+			"body":          map[string]interface{}{"refreshToken": "t0k3n"},
+		}, "request sent")
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), &entry))
+		assert.Equal(t, "trace", entry["level"])
+		assert.Equal(t, "request sent", entry["msg"])
+		assert.Equal(t, "payment-gateway.client", entry["component"])
+		assert.Equal(t, "billing", entry["service"])
+		assert.Equal(t, DefaultRedactionReplacement, entry["Password"])
+		assert.Equal(t, "***", entry["authorization"])
+		assert.Equal(t, "alice", entry["user"])
+		assert.Equal(t, DefaultRedactionReplacement, entry["apiToken"])
+		assert.Equal(t, map[string]interface{}{"refreshToken": DefaultRedactionReplacement}, entry["body"])
+	})
+
+	t.Run("slog-provider", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{Provider: ProviderSlog, Level: "trace", Format: FormatJSON, Tags: slf4go_api.LogTags{"service": "billing"}}.Build(&buffer)
+		require.NoError(t, err)
+
+		logging.Logger.ForComponent("billing").Tracef("written")
+
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal(buffer.Bytes(), &entry))
+		assert.Equal(t, "TRACE", entry["level"])
+		assert.Equal(t, "written", entry["msg"])
+		assert.Equal(t, "billing", entry["appComponent"])
+		assert.Equal(t, "billing", entry["service"])
+	})
+
+	t.Run("tags-of-derived-loggers", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{Format: FormatJSON, Tags: slf4go_api.LogTags{"service": "billing", "region": "eu-west-1"}}.Build(&buffer)
+		require.NoError(t, err)
+
+		logger := logging.Logger.ForComponent("billing").WithStaticTags(slf4go_api.LogTags{"region": "us-east-1", "tenant": "acme"})
+		logger.Infof("own tags")
+		slf4go_api.AddStaticTags(logger, slf4go_api.LogTags{"request": "42"}).Infof("added tags")
+		logger.WithStaticTags(nil).Infof("replaced tags")
+
+		entries := jsonEntries(t, &buffer)
+		require.Len(t, entries, 3)
+		assert.Equal(t, "us-east-1", entries[0]["region"])
+		assert.Equal(t, "acme", entries[0]["tenant"])
+		assert.Equal(t, "billing", entries[0]["service"])
+		assert.Equal(t, "42", entries[1]["request"])
+		assert.Equal(t, "acme", entries[1]["tenant"])
+		assert.Equal(t, "billing", entries[1]["service"])
+		assert.Equal(t, map[string]interface{}{
+			"level":                           "info",
+			"msg":                             "replaced tags",
+			"service":                         "billing",
+			"region":                          "eu-west-1",
+			slf4go_api.DefaultAppComponentTag: "billing",
+		}, entries[2])
+	})
+
+	t.Run("tags-of-configured-loggers", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{Format: FormatJSON, Tags: slf4go_api.LogTags{"service": "billing", "team": "platform"}}.Build(&buffer)
+		require.NoError(t, err)
+		slf4go_api.RegisterProvider(logging.Provider())
+		slf4go_api.ConfigureLogger("config-tags", slf4go_api.LoggerConfig{Tags: slf4go_api.LogTags{"team": "payments"}})
+
+		slf4go_api.GetLogger("config-tags").Infof("test message")
+
+		entries := jsonEntries(t, &buffer)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "billing", entries[0]["service"])
+		assert.Equal(t, "payments", entries[0]["team"])
+	})
+
+	t.Run("registered-provider", func(t *testing.T) {
+		recorder := slf4go_test.NewRecordingLogger()
+		var format string
+		RegisterProviderFactory("recording", func(writer io.Writer, f string) slf4go_api.Slf4GoLogger {
+			format = f
+			return recorder
+		})
+		t.Cleanup(func() {
+			providersMutex.Lock()
+			defer providersMutex.Unlock()
+			delete(providers, "recording")
+		})
+		logging, err := Config{Provider: "recording", Format: "JSON"}.Build(&bytes.Buffer{})
+		require.NoError(t, err)
+
+		logging.Logger.Infof("written")
+
+		assert.Equal(t, FormatJSON, format)
+		slf4go_test.AssertLog(t, recorder).HasCount(1).Last().HasMessage("written")
+	})
+
+	t.Run("levels-changeable-at-runtime", func(t *testing.T) {
+		var buffer bytes.Buffer
+		logging, err := Config{Level: "info"}.Build(&buffer)
+		require.NoError(t, err)
+
+		logging.Levels.SetLevel("billing", slf4go_api.Debug)
+		logging.Logger.ForComponent("billing").Debugf("written")
+
+		assert.Contains(t, buffer.String(), "msg=written")
+	})
+
+	t.Run("provider", func(t *testing.T) {
+		logging, err := Config{}.Build(&bytes.Buffer{})
+		require.NoError(t, err)
+		assert.Same(t, logging.Logger, logging.Provider().RootLogger())
+	})
+}
+
+func jsonEntries(t *testing.T, buffer *bytes.Buffer) []map[string]interface{} {
+	var entries []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		delete(entry, "time")
+		entries = append(entries, entry)
+	}
+	return entries
+}
@@ -0,0 +1,73 @@
+package slf4go_config
+
+import (
+	"reflect"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(tagsLogger{}).PkgPath())
+}
+
+// tagsLogger adds the configured tags to the static tags of all loggers derived from it. WithStaticTags replaces
+// the static tags of the backend logger, so without it a derived logger setting its own static tags would drop the
+// configured ones. The logging methods are those of the embedded backend logger, which holds the configured tags.
+type tagsLogger struct {
+	slf4go_api.Slf4GoLogger
+	tags slf4go_api.LogTags
+}
+
+// newTagsLogger adds the given tags to the static tags of the given logger and of all loggers derived from it.
+func newTagsLogger(logger slf4go_api.Slf4GoLogger, tags slf4go_api.LogTags) *tagsLogger {
+	return &tagsLogger{Slf4GoLogger: logger.WithStaticTags(tags), tags: tags}
+}
+
+func (l *tagsLogger) derive(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+	return &tagsLogger{Slf4GoLogger: logger, tags: l.tags}
+}
+
+func (l *tagsLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.ForComponent(component))
+}
+
+func (l *tagsLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithAppComponentLabel(componentTagLabel))
+}
+
+// WithStaticTags replaces the static tags of the logger except for the configured tags, which the given tags take
+// precedence over.
+func (l *tagsLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithStaticTags(combineTags(l.tags, tags)))
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *tagsLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(slf4go_api.AddStaticTags(l.Slf4GoLogger, tags))
+}
+
+func (l *tagsLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithError(err))
+}
+
+func (l *tagsLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithCallerReporting(enabled))
+}
+
+func (l *tagsLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithStackTrace(threshold))
+}
+
+func (l *tagsLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	return l.derive(l.Slf4GoLogger.WithComponentLevels(levels))
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
@@ -0,0 +1,215 @@
+package slf4go_config
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// DefaultPollInterval is the interval a Watcher checks its config file for changes at, unless set via WithPollInterval.
+const DefaultPollInterval = 5 * time.Second
+
+// Watcher keeps the logging of the process in line with a config file. It builds the loggers from the file,
+// installs them via slf4go_api.RegisterProvider and rebuilds them whenever the file changes or the process
+// receives SIGHUP. All loggers obtained via slf4go_api.GetLogger switch to the new levels, static tags, format and
+// output with their next entry, including loggers handed out before. The new levels and loggers are built completely
+// and published together via slf4go_api.RegisterProvider, so no entry is filtered by the new levels but written by
+// the previous loggers or vice versa. The previous output is closed afterwards, entries still being written by the
+// previous loggers are forwarded to the new output.
+//
+// Loggers not obtained via slf4go_api.GetLogger, e.g. a Slf4GoLogrusLogger built by a ProviderFactory and also
+// used directly, are snapshots: they keep the levels, static tags and format they were built with. Their entries
+// are forwarded to the new output.
+//
+// An invalid config file leaves the current logging in place and is reported to the error handler.
+type Watcher struct {
+	path         string
+	pollInterval time.Duration
+	onError      func(err error)
+
+	mutex   sync.Mutex
+	config  Config
+	levels  *slf4go_api.ComponentLevels
+	output  *swappableWriter
+	modTime time.Time
+	size    int64
+
+	signals   chan os.Signal
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// WatchOption configures a Watcher created by Watch.
+type WatchOption func(watcher *Watcher)
+
+// WithPollInterval sets the interval the config file is checked for changes at. Defaults to DefaultPollInterval.
+func WithPollInterval(interval time.Duration) WatchOption {
+	return func(watcher *Watcher) {
+		watcher.pollInterval = interval
+	}
+}
+
+// WithErrorHandler sets the function reloading errors are reported to. Defaults to writing them to stderr.
+func WithErrorHandler(onError func(err error)) WatchOption {
+	return func(watcher *Watcher) {
+		watcher.onError = onError
+	}
+}
+
+// Watch loads the config file (see LoadFile), installs the loggers built from it and watches it for changes
+// until Close is called. An invalid initial config is returned as error.
+func Watch(path string, options ...WatchOption) (*Watcher, error) {
+	watcher := &Watcher{
+		path:         path,
+		pollInterval: DefaultPollInterval,
+		onError: func(err error) {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to reload logging config, %v\n", err)
+		},
+		signals: make(chan os.Signal, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	for _, option := range options {
+		option(watcher)
+	}
+	if err := watcher.Reload(); err != nil {
+		return nil, err
+	}
+	signal.Notify(watcher.signals, syscall.SIGHUP)
+	go watcher.run()
+	return watcher, nil
+}
+
+// Levels returns the levels of the config currently in effect. They can be changed at runtime, but every reload
+// replaces them with new levels built from the config file, dropping runtime changes. Call Levels for every change,
+// e.g. via slf4go_admin.NewHandlerFunc(watcher.Levels), instead of keeping the returned levels.
+func (w *Watcher) Levels() *slf4go_api.ComponentLevels {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.levels
+}
+
+// Config returns the config currently in effect.
+func (w *Watcher) Config() Config {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.config
+}
+
+// Reload reads the config file and installs the loggers built from it. If the file cannot be read or is invalid,
+// the current logging is left in place and the error is returned.
+func (w *Watcher) Reload() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	// stat before reading, so that changes during the reload are picked up by the next poll
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return err
+	}
+	config, err := LoadFile(w.path)
+	if err != nil {
+		return fmt.Errorf("invalid logging config '%s': %w", w.path, err)
+	}
+	previous, output := w.output, w.output
+	if output == nil || config.Output != w.config.Output {
+		writer, err := config.OpenOutput()
+		if err != nil {
+			return err
+		}
+		output = &swappableWriter{writer: writer}
+	}
+	logging := config.build(output)
+
+	slf4go_api.RegisterProvider(logging.Provider())
+	if previous != nil && previous != output {
+		// loggers of the previous config may still be writing, forward their entries to the new output
+		if err := previous.swap(nopCloser{output}); err != nil {
+			w.onError(err)
+		}
+	}
+	w.output = output
+	w.levels = logging.Levels
+	w.config = config
+	w.modTime, w.size = info.ModTime(), info.Size()
+	return nil
+}
+
+// Close stops watching the config file and closes the output. Loggers keep writing to the closed output,
+// so Close is meant to be called when the process shuts down.
+func (w *Watcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		signal.Stop(w.signals)
+		close(w.stop)
+		<-w.done
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		err = w.output.swap(nopCloser{io.Discard})
+	})
+	return err
+}
+
+func (w *Watcher) run() {
+	defer close(w.done)
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-w.signals:
+			w.reload()
+		case <-ticker.C:
+			if w.changed() {
+				w.reload()
+			}
+		}
+	}
+}
+
+func (w *Watcher) reload() {
+	if err := w.Reload(); err != nil {
+		w.onError(err)
+	}
+}
+
+// changed reports whether the config file was modified since it was last loaded.
+func (w *Watcher) changed() bool {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		w.onError(err)
+		return false
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return !info.ModTime().Equal(w.modTime) || info.Size() != w.size
+}
+
+// swappableWriter is the writer of the loggers built by a Watcher for an output, so that the output can be closed
+// while these loggers are still in use.
+type swappableWriter struct {
+	mutex  sync.Mutex
+	writer io.WriteCloser
+}
+
+func (w *swappableWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.writer.Write(p)
+}
+
+// swap replaces the writer and closes the previous one once no write is in progress.
+func (w *swappableWriter) swap(writer io.WriteCloser) error {
+	w.mutex.Lock()
+	previous := w.writer
+	w.writer = writer
+	w.mutex.Unlock()
+	return previous.Close()
+}
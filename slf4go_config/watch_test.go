@@ -0,0 +1,295 @@
+package slf4go_config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+func writeConfig(t *testing.T, path string, config string) {
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+}
+
+func readLines(t *testing.T, path string) []string {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	firstOutput := filepath.Join(dir, "first.log")
+	secondOutput := filepath.Join(dir, "second.log")
+	writeConfig(t, path, fmt.Sprintf("level: info\ntags:\n  version: 1\noutput: %s\n", firstOutput))
+
+	watcher, err := Watch(path, WithPollInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer watcher.Close()
+	// handed out before the reload and kept, as done by libraries
+	logger := slf4go_api.GetLogger("billing")
+
+	logger.Infof("first")
+	logger.Debugf("discarded")
+	assert.Equal(t, slf4go_api.Info, watcher.Levels().Level("billing"))
+
+	writeConfig(t, path, fmt.Sprintf("level: info\ncomponentLevels:\n  billing: debug\nformat: json\ntags:\n  version: 2\noutput: %s\n", secondOutput))
+	require.Eventually(t, func() bool {
+		return watcher.Config().Output == secondOutput
+	}, time.Second, 5*time.Millisecond)
+
+	logger.Debugf("second")
+
+	assert.Equal(t, []string{"first"}, messagesOf(t, readLines(t, firstOutput)))
+	assert.Contains(t, readLines(t, firstOutput)[0], "version=1")
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(readLines(t, secondOutput)[0]), &entry))
+	assert.Equal(t, "second", entry["msg"])
+	assert.Equal(t, "debug", entry["level"])
+	assert.Equal(t, float64(2), entry["version"])
+	assert.Equal(t, "billing", entry[slf4go_api.DefaultAppComponentTag])
+}
+
+func messagesOf(t *testing.T, lines []string) []string {
+	var messages []string
+	for _, line := range lines {
+		_, message, ok := strings.Cut(line, "msg=")
+		require.True(t, ok, line)
+		messages = append(messages, strings.Fields(message)[0])
+	}
+	return messages
+}
+
+func TestWatch_SIGHUP(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.json")
+	writeConfig(t, path, `{"level": "info", "output": "stderr"}`)
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	writeConfig(t, path, `{"level": "trace", "output": "stderr"}`)
+	process, err := os.FindProcess(os.Getpid())
+	require.NoError(t, err)
+	require.NoError(t, process.Signal(syscall.SIGHUP))
+
+	require.Eventually(t, func() bool {
+		return watcher.Levels().DefaultLevel() == slf4go_api.Trace
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestWatch_InvalidConfig(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+
+	t.Run("initial", func(t *testing.T) {
+		writeConfig(t, path, "level: verbose\n")
+		_, err := Watch(path)
+		assert.ErrorIs(t, err, slf4go_api.ErrUnknownLevel)
+	})
+
+	t.Run("reload-keeps-current-logging", func(t *testing.T) {
+		writeConfig(t, path, "level: debug\noutput: stderr\n")
+		errs := make(chan error, 10)
+		watcher, err := Watch(path, WithPollInterval(10*time.Millisecond), WithErrorHandler(func(err error) {
+			errs <- err
+		}))
+		require.NoError(t, err)
+		defer watcher.Close()
+
+		writeConfig(t, path, "level: verbose\nformat: xml\n")
+
+		select {
+		case err := <-errs:
+			assert.ErrorIs(t, err, slf4go_api.ErrUnknownLevel)
+			assert.ErrorContains(t, err, "unknown format 'xml'")
+		case <-time.After(time.Second):
+			t.Fatal("invalid config not reported")
+		}
+		assert.Equal(t, "debug", watcher.Config().Level)
+		assert.Equal(t, slf4go_api.Debug, watcher.Levels().DefaultLevel())
+	})
+}
+
+func TestWatch_ReloadReplacesRuntimeLevels(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	writeConfig(t, path, "componentLevels:\n  billing: debug\n  shipping: warn\noutput: "+filepath.Join(dir, "a.log")+"\n")
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	watcher.Levels().SetLevel("payment-gateway", slf4go_api.Trace)
+	watcher.Levels().SetDefaultLevel(slf4go_api.Error)
+	writeConfig(t, path, "level: warn\ncomponentLevels:\n  billing: trace\noutput: "+filepath.Join(dir, "a.log")+"\n")
+	require.NoError(t, watcher.Reload())
+
+	assert.Equal(t, slf4go_api.Warn, watcher.Levels().DefaultLevel())
+	assert.Equal(t, map[slf4go_api.AppComponent]slf4go_api.LogLevel{"billing": slf4go_api.Trace}, watcher.Levels().Overrides())
+}
+
+func TestWatch_PublishesLevelsTogetherWithLoggers(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	output := filepath.Join(dir, "a.log")
+	configs := []string{
+		"level: info\nformat: text\noutput: " + output + "\n",
+		"level: debug\nformat: json\noutput: " + output + "\n",
+	}
+	writeConfig(t, path, configs[0])
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	logger := slf4go_api.GetLogger("billing")
+	levels := watcher.Levels()
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					logger.Debugf("entry")
+				}
+			}
+		}()
+	}
+	for i := 1; i <= 20; i++ {
+		writeConfig(t, path, configs[i%2])
+		require.NoError(t, watcher.Reload())
+		logger.Debugf("reloaded")
+	}
+	close(stop)
+	wg.Wait()
+	require.NoError(t, watcher.Close())
+
+	// debug entries are only enabled by the config writing JSON
+	for _, line := range readLines(t, output) {
+		assert.True(t, strings.HasPrefix(line, "{"), line)
+	}
+	assert.Equal(t, slf4go_api.Info, levels.DefaultLevel(), "levels of the initial config are not changed")
+	assert.Equal(t, slf4go_api.Info, watcher.Levels().DefaultLevel())
+}
+
+func TestWatch_ProviderLoggersKeepTheirConfig(t *testing.T) {
+	var built []slf4go_api.Slf4GoLogger
+	RegisterProviderFactory("snapshot", func(writer io.Writer, format string) slf4go_api.Slf4GoLogger {
+		logger := nativeProvider(writer, format)
+		built = append(built, logger)
+		return logger
+	})
+	t.Cleanup(func() {
+		providersMutex.Lock()
+		defer providersMutex.Unlock()
+		delete(providers, "snapshot")
+	})
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	output := filepath.Join(dir, "a.log")
+	writeConfig(t, path, "provider: snapshot\nformat: text\noutput: "+output+"\n")
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	defer watcher.Close()
+
+	writeConfig(t, path, "provider: snapshot\nformat: json\noutput: "+output+"\n")
+	require.NoError(t, watcher.Reload())
+	built[0].Infof("snapshot")
+	slf4go_api.GetLogger("billing").Infof("reloaded")
+
+	lines := readLines(t, output)
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], "level=info msg=snapshot")
+	assert.True(t, strings.HasPrefix(lines[1], "{"), lines[1])
+}
+
+func TestWatch_PublishesConfigTogetherWithOutput(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	outputs := []string{filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")}
+	writeConfig(t, path, "tags:\n  version: 0\noutput: "+outputs[0]+"\n")
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	logger := slf4go_api.GetLogger("billing")
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					logger.Infof("entry")
+				}
+			}
+		}()
+	}
+	for i := 1; i <= 10; i++ {
+		writeConfig(t, path, fmt.Sprintf("tags:\n  version: %d\noutput: %s\n", i, outputs[i%2]))
+		require.NoError(t, watcher.Reload())
+		// the version of the new config is published together with its output
+		logger.Infof("reloaded")
+		data, err := os.ReadFile(outputs[i%2])
+		require.NoError(t, err)
+		assert.Contains(t, string(data), fmt.Sprintf("msg=reloaded appComponent=billing version=%d\n", i))
+	}
+	close(stop)
+	wg.Wait()
+	require.NoError(t, watcher.Close())
+}
+
+func TestWatch_NoEntriesLostWhileSwappingOutputs(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "logging.yaml")
+	outputs := []string{filepath.Join(dir, "a.log"), filepath.Join(dir, "b.log")}
+	writeConfig(t, path, "output: "+outputs[0]+"\n")
+	watcher, err := Watch(path, WithPollInterval(time.Hour))
+	require.NoError(t, err)
+	logger := slf4go_api.GetLogger("billing")
+
+	const goroutines, entries = 8, 200
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < entries; i++ {
+				logger.Infof("entry")
+			}
+		}()
+	}
+	for i := 1; i <= 20; i++ {
+		writeConfig(t, path, "output: "+outputs[i%2]+"\n")
+		require.NoError(t, watcher.Reload())
+	}
+	wg.Wait()
+	require.NoError(t, watcher.Close())
+
+	var written int
+	for _, output := range outputs {
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		written += bytes.Count(data, []byte("msg=entry"))
+	}
+	assert.Equal(t, goroutines*entries, written)
+}
@@ -0,0 +1,407 @@
+// Package slf4go_file provides a file output rotating by size and time, to be used as the io.Writer of any backend.
+package slf4go_file
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// backupTimeFormat is the format of the rotation time in the names of rotated files.
+const backupTimeFormat = "2006-01-02T15-04-05.000"
+
+// compressSuffix is appended to the names of rotated files compressed with gzip.
+const compressSuffix = ".gz"
+
+// Option configures a RollingFile.
+type Option func(file *RollingFile)
+
+// WithMaxSize rotates the file before a write would make it exceed maxBytes. A single write larger than maxBytes
+// goes into a file of its own. Sizes of 0 or less disable rotation by size, which is the default.
+func WithMaxSize(maxBytes int64) Option {
+	return func(file *RollingFile) {
+		file.maxSize = maxBytes
+	}
+}
+
+// WithRotationInterval rotates the file by the first write after each multiple of interval since the zero time,
+// e.g. after every full hour for time.Hour or after midnight UTC for 24*time.Hour. Intervals of 0 or less disable
+// rotation by time, which is the default.
+func WithRotationInterval(interval time.Duration) Option {
+	return func(file *RollingFile) {
+		file.interval = interval
+	}
+}
+
+// WithCompression compresses rotated files with gzip.
+func WithCompression() Option {
+	return func(file *RollingFile) {
+		file.compress = true
+	}
+}
+
+// WithMaxFiles keeps at most n rotated files, removing the oldest ones. Values of 0 or less keep all files.
+func WithMaxFiles(n int) Option {
+	return func(file *RollingFile) {
+		file.maxFiles = n
+	}
+}
+
+// WithMaxAge removes rotated files that were rotated more than maxAge ago, e.g. 7*24*time.Hour to keep a week of
+// logs. Values of 0 or less keep all files.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(file *RollingFile) {
+		file.maxAge = maxAge
+	}
+}
+
+// WithReopenOnSIGHUP reopens the file whenever the process receives SIGHUP, as expected by logrotate moving the
+// file away and signalling the process instead of using copytruncate.
+func WithReopenOnSIGHUP() Option {
+	return func(file *RollingFile) {
+		file.signals = make(chan os.Signal, 1)
+	}
+}
+
+// WithErrorHandler sets the function called with errors of compressing and removing rotated files, which happen in
+// the background, and of rotations after which writing continues to the unrotated file. By default, these errors
+// are reported on stderr.
+func WithErrorHandler(onError func(err error)) Option {
+	return func(file *RollingFile) {
+		file.onError = onError
+	}
+}
+
+// RollingFile is an io.WriteCloser appending to a file and rotating it by size and time. Rotated files are renamed
+// to <name>-<rotation time><extension>, e.g. app-2024-01-02T15-04-05.000.log, in the same directory, optionally
+// compressed with gzip and removed once they exceed the retention limits.
+//
+// RollingFile is safe for concurrent use, so a single RollingFile can be shared by all loggers and backends writing
+// to the same file. Each call to Write is written to a single file, so entries are never split across files.
+type RollingFile struct {
+	mutex        sync.Mutex
+	path         string
+	file         *os.File
+	size         int64
+	nextRotation time.Time
+	closed       bool
+
+	maxSize  int64
+	interval time.Duration
+	compress bool
+	maxFiles int
+	maxAge   time.Duration
+	now      func() time.Time
+	onError  func(err error)
+
+	// millMutex serializes compressing and removing rotated files, which happens in the background.
+	millMutex sync.Mutex
+	milling   sync.WaitGroup
+	signals   chan os.Signal
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+// Open opens the file at path for appending, creating it and its directory if necessary.
+func Open(path string, options ...Option) (*RollingFile, error) {
+	f := &RollingFile{
+		path: path,
+		now:  time.Now,
+		onError: func(err error) {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to rotate or clean up log files, %v\n", err)
+		},
+	}
+	for _, option := range options {
+		option(f)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	if f.signals != nil {
+		f.stop, f.stopped = make(chan struct{}), make(chan struct{})
+		signal.Notify(f.signals, syscall.SIGHUP)
+		go f.reopenOnSignal()
+	}
+	return f, nil
+}
+
+// Write appends p to the file, rotating the file first if p would exceed the maximum size or a rotation interval
+// has passed.
+func (f *RollingFile) Write(p []byte) (int, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.closed {
+		return 0, os.ErrClosed
+	}
+	if f.dueForRotation(int64(len(p))) {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+// Rotate rotates the file regardless of its size and age. If the file cannot be renamed or the new file cannot be
+// opened, the error is reported via the error handler and writing continues to the file at the path.
+func (f *RollingFile) Rotate() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.closed {
+		return os.ErrClosed
+	}
+	return f.rotate()
+}
+
+// Reopen opens the file at the path again, which has been moved away by an external rotator, and closes the file
+// written so far. If the file at the path cannot be opened, writing continues to the file written so far.
+func (f *RollingFile) Reopen() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.closed {
+		return os.ErrClosed
+	}
+	previous := f.file
+	if err := f.open(); err != nil {
+		return err
+	}
+	return previous.Close()
+}
+
+// Close closes the file, stops listening for SIGHUP and waits until the rotated files have been compressed and
+// cleaned up.
+func (f *RollingFile) Close() error {
+	f.mutex.Lock()
+	if f.closed {
+		f.mutex.Unlock()
+		return nil
+	}
+	f.closed = true
+	err := f.file.Close()
+	f.mutex.Unlock()
+
+	if f.signals != nil {
+		signal.Stop(f.signals)
+		close(f.stop)
+		<-f.stopped
+	}
+	f.milling.Wait()
+	return err
+}
+
+func (f *RollingFile) reopenOnSignal() {
+	defer close(f.stopped)
+	for {
+		select {
+		case <-f.signals:
+			if err := f.Reopen(); err != nil && !errors.Is(err, os.ErrClosed) {
+				f.onError(err)
+			}
+		case <-f.stop:
+			return
+		}
+	}
+}
+
+// open opens the file at the path for appending. The file written so far is only replaced if opening succeeds.
+// The caller holds the mutex.
+func (f *RollingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file, f.size = file, info.Size()
+	if f.interval > 0 {
+		f.nextRotation = f.now().Truncate(f.interval).Add(f.interval)
+	}
+	return nil
+}
+
+// dueForRotation reports whether the file has to be rotated before writing n bytes. Empty files are never rotated,
+// instead the next rotation time is moved on if it has passed. The caller holds the mutex.
+func (f *RollingFile) dueForRotation(n int64) bool {
+	if f.interval > 0 && !f.now().Before(f.nextRotation) {
+		if f.size > 0 {
+			return true
+		}
+		f.nextRotation = f.now().Truncate(f.interval).Add(f.interval)
+	}
+	return f.size > 0 && f.maxSize > 0 && f.size+n > f.maxSize
+}
+
+// rotate renames the file to its backup name, opens a new file and cleans up the rotated files in the background.
+// If renaming or opening fails, the file at the path is opened again and the error is reported via onError, so
+// that writing continues. Only if that fails as well, the error is returned. The caller holds the mutex.
+func (f *RollingFile) rotate() error {
+	if err := f.renameAndOpen(); err != nil {
+		if reopenErr := f.open(); reopenErr != nil {
+			return errors.Join(err, reopenErr)
+		}
+		f.onError(fmt.Errorf("rotating %s failed, continuing with the unrotated file: %w", f.path, err))
+		return nil
+	}
+	f.milling.Add(1)
+	go func() {
+		defer f.milling.Done()
+		f.mill()
+	}()
+	return nil
+}
+
+// renameAndOpen closes the file, renames it to its backup name and opens a new file at the path. The caller holds
+// the mutex.
+func (f *RollingFile) renameAndOpen() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.path, f.backupName(f.now())); err != nil {
+		return err
+	}
+	return f.open()
+}
+
+// backupName returns the name a file rotated at the given time is renamed to, adding a counter if a file of that
+// name already exists.
+func (f *RollingFile) backupName(rotatedAt time.Time) string {
+	dir, prefix, ext := f.nameParts()
+	rotatedAt = rotatedAt.Local()
+	name := filepath.Join(dir, prefix+rotatedAt.Format(backupTimeFormat)+ext)
+	for i := 1; exists(name) || exists(name+compressSuffix); i++ {
+		name = filepath.Join(dir, fmt.Sprintf("%s%s-%d%s", prefix, rotatedAt.Format(backupTimeFormat), i, ext))
+	}
+	return name
+}
+
+// nameParts splits the path into the directory, the prefix of rotated files and the extension.
+func (f *RollingFile) nameParts() (dir, prefix, ext string) {
+	base := filepath.Base(f.path)
+	ext = filepath.Ext(base)
+	return filepath.Dir(f.path), strings.TrimSuffix(base, ext) + "-", ext
+}
+
+func exists(name string) bool {
+	_, err := os.Lstat(name)
+	return err == nil
+}
+
+// mill removes the rotated files exceeding the retention limits and compresses the remaining ones. As rotations
+// may overtake each other's mill, each mill processes all rotated files rather than the one just rotated.
+func (f *RollingFile) mill() {
+	f.millMutex.Lock()
+	defer f.millMutex.Unlock()
+	files, err := f.rotatedFiles()
+	if err != nil {
+		f.onError(err)
+		return
+	}
+	var errs []error
+	for i, file := range files {
+		switch {
+		case f.expired(i, file):
+			if err := os.Remove(file.name); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, err)
+			}
+		case f.compress && !strings.HasSuffix(file.name, compressSuffix):
+			if err := compressFile(file.name); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		f.onError(err)
+	}
+}
+
+// expired reports whether the given rotated file, the i-th newest one, exceeds the maximum number of files
+// or the maximum age.
+func (f *RollingFile) expired(i int, file rotatedFile) bool {
+	return (f.maxFiles > 0 && i >= f.maxFiles) || (f.maxAge > 0 && file.rotatedAt.Before(f.now().Add(-f.maxAge)))
+}
+
+func compressFile(name string) (err error) {
+	source, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = source.Close() }()
+	target, err := os.OpenFile(name+compressSuffix, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	writer := gzip.NewWriter(target)
+	if _, err = io.Copy(writer, source); err == nil {
+		err = writer.Close()
+	}
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(name + compressSuffix)
+		return err
+	}
+	return os.Remove(name)
+}
+
+// rotatedFile is a file rotated by a RollingFile, as found in the directory.
+type rotatedFile struct {
+	name      string
+	rotatedAt time.Time
+	// counter distinguishes files rotated at the same time, 0 for the first one.
+	counter int
+}
+
+// rotatedFiles lists the rotated files, newest first.
+func (f *RollingFile) rotatedFiles() ([]rotatedFile, error) {
+	dir, prefix, ext := f.nameParts()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []rotatedFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, prefix) || len(name) < len(prefix)+len(backupTimeFormat) {
+			continue
+		}
+		if !strings.HasSuffix(name, ext) && !strings.HasSuffix(name, ext+compressSuffix) {
+			continue
+		}
+		rotatedAt, err := time.ParseInLocation(backupTimeFormat, name[len(prefix):len(prefix)+len(backupTimeFormat)], time.Local)
+		if err != nil {
+			continue
+		}
+		var counter int
+		rest := strings.TrimSuffix(strings.TrimSuffix(name[len(prefix)+len(backupTimeFormat):], compressSuffix), ext)
+		if rest != "" {
+			if _, err := fmt.Sscanf(rest, "-%d", &counter); err != nil {
+				continue
+			}
+		}
+		files = append(files, rotatedFile{name: filepath.Join(dir, name), rotatedAt: rotatedAt, counter: counter})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if files[i].rotatedAt.Equal(files[j].rotatedAt) {
+			return files[i].counter > files[j].counter
+		}
+		return files[i].rotatedAt.After(files[j].rotatedAt)
+	})
+	return files, nil
+}
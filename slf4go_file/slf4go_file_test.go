@@ -0,0 +1,310 @@
+package slf4go_file
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_logrus_provider"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+)
+
+var testTime = time.Date(2024, 1, 2, 10, 30, 0, 0, time.Local)
+
+// fakeClock is a clock for rotation times, safe for use by the background cleanup.
+type fakeClock struct {
+	mutex sync.Mutex
+	now   time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func withClock(clock *fakeClock) Option {
+	return func(file *RollingFile) {
+		file.now = clock.Now
+	}
+}
+
+func openTestFile(t *testing.T, options ...Option) (*RollingFile, *fakeClock) {
+	clock := &fakeClock{now: testTime}
+	file, err := Open(filepath.Join(t.TempDir(), "logs", "app.log"), append([]Option{withClock(clock)}, options...)...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = file.Close() })
+	return file, clock
+}
+
+func write(t *testing.T, file *RollingFile, lines ...string) {
+	for _, line := range lines {
+		_, err := io.WriteString(file, line+"\n")
+		require.NoError(t, err)
+	}
+}
+
+// dirContents returns the contents of all files in the directory of the rolling file by name, decompressing
+// gzipped files.
+func dirContents(t *testing.T, file *RollingFile) map[string]string {
+	entries, err := os.ReadDir(filepath.Dir(file.path))
+	require.NoError(t, err)
+	contents := make(map[string]string)
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(filepath.Dir(file.path), entry.Name()))
+		require.NoError(t, err)
+		if strings.HasSuffix(entry.Name(), compressSuffix) {
+			reader, err := gzip.NewReader(bytes.NewReader(data))
+			require.NoError(t, err)
+			data, err = io.ReadAll(reader)
+			require.NoError(t, err)
+		}
+		contents[entry.Name()] = string(data)
+	}
+	return contents
+}
+
+func TestRollingFile_RotatesBySize(t *testing.T) {
+	file, clock := openTestFile(t, WithMaxSize(12))
+
+	write(t, file, "first", "second")
+	clock.Advance(time.Second)
+	write(t, file, "third")
+	write(t, file, "a line longer than the maximum size")
+	write(t, file, "fifth")
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, map[string]string{
+		"app-2024-01-02T10-30-00.000.log":   "first\n",
+		"app-2024-01-02T10-30-01.000.log":   "second\n",
+		"app-2024-01-02T10-30-01.000-1.log": "third\n",
+		"app-2024-01-02T10-30-01.000-2.log": "a line longer than the maximum size\n",
+		"app.log":                           "fifth\n",
+	}, dirContents(t, file))
+}
+
+func TestRollingFile_RotatesByTime(t *testing.T) {
+	file, clock := openTestFile(t, WithRotationInterval(time.Hour))
+
+	write(t, file, "10:30")
+	clock.Advance(29 * time.Minute)
+	write(t, file, "10:59")
+	clock.Advance(time.Minute + 500*time.Millisecond)
+	write(t, file, "11:00")
+	clock.Advance(2 * time.Hour)
+	require.NoError(t, file.Reopen())
+	clock.Advance(time.Hour)
+	write(t, file, "14:00")
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, map[string]string{
+		"app-2024-01-02T11-00-00.500.log": "10:30\n10:59\n",
+		"app-2024-01-02T14-00-00.500.log": "11:00\n",
+		"app.log":                         "14:00\n",
+	}, dirContents(t, file))
+}
+
+func TestRollingFile_SkipsRotationOfEmptyFile(t *testing.T) {
+	file, clock := openTestFile(t, WithRotationInterval(time.Hour))
+
+	clock.Advance(time.Hour)
+	write(t, file, "11:30")
+	clock.Advance(10 * time.Minute)
+	write(t, file, "11:40")
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, map[string]string{"app.log": "11:30\n11:40\n"}, dirContents(t, file))
+}
+
+func TestRollingFile_Compression(t *testing.T) {
+	file, _ := openTestFile(t, WithCompression())
+
+	write(t, file, "first")
+	require.NoError(t, file.Rotate())
+	write(t, file, "second")
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, map[string]string{
+		"app-2024-01-02T10-30-00.000.log.gz": "first\n",
+		"app.log":                            "second\n",
+	}, dirContents(t, file))
+}
+
+func TestRollingFile_Retention(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		options  []Option
+		expected []string
+	}{
+		{"max-files", []Option{WithMaxFiles(2)}, []string{"app-2024-01-04T10-30-00.000.log.gz", "app-2024-01-05T10-30-00.000.log.gz"}},
+		{"max-age", []Option{WithMaxAge(48 * time.Hour)}, []string{
+			"app-2024-01-03T10-30-00.000.log.gz", "app-2024-01-04T10-30-00.000.log.gz", "app-2024-01-05T10-30-00.000.log.gz",
+		}},
+		{"unlimited", nil, []string{
+			"app-2024-01-02T10-30-00.000.log.gz", "app-2024-01-03T10-30-00.000.log.gz",
+			"app-2024-01-04T10-30-00.000.log.gz", "app-2024-01-05T10-30-00.000.log.gz",
+		}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			file, clock := openTestFile(t, append(scenario.options, WithCompression())...)
+			dir := filepath.Dir(file.path)
+			for _, unrelated := range []string{"app-notes.log", "other.log", "app-2024-01-01.log"} {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, unrelated), []byte("kept"), 0o644))
+			}
+
+			for day := 0; day < 4; day++ {
+				if day > 0 {
+					clock.Advance(24 * time.Hour)
+				}
+				write(t, file, fmt.Sprintf("day %d", day))
+				require.NoError(t, file.Rotate())
+			}
+			require.NoError(t, file.Close())
+
+			var rotated []string
+			for name := range dirContents(t, file) {
+				if strings.HasSuffix(name, compressSuffix) {
+					rotated = append(rotated, name)
+				}
+			}
+			sort.Strings(rotated)
+			assert.Equal(t, scenario.expected, rotated)
+			assert.Subset(t, keys(dirContents(t, file)), []string{"app.log", "app-notes.log", "other.log", "app-2024-01-01.log"})
+		})
+	}
+}
+
+func keys(contents map[string]string) []string {
+	var names []string
+	for name := range contents {
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestRollingFile_ReopenOnSIGHUP(t *testing.T) {
+	file, _ := openTestFile(t, WithReopenOnSIGHUP())
+	write(t, file, "before logrotate")
+
+	require.NoError(t, os.Rename(file.path, file.path+".1"))
+	require.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(file.path)
+		return err == nil
+	}, time.Second, time.Millisecond)
+	write(t, file, "after logrotate")
+	require.NoError(t, file.Close())
+
+	assert.Equal(t, map[string]string{
+		"app.log.1": "before logrotate\n",
+		"app.log":   "after logrotate\n",
+	}, dirContents(t, file))
+}
+
+func TestRollingFile_RotationFailure(t *testing.T) {
+	var errs []error
+	file, _ := openTestFile(t, WithErrorHandler(func(err error) { errs = append(errs, err) }))
+	write(t, file, "removed")
+	require.NoError(t, os.Remove(file.path))
+
+	require.NoError(t, file.Rotate())
+	write(t, file, "after failed rotation")
+	require.NoError(t, file.Close())
+
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], os.ErrNotExist)
+	assert.Equal(t, map[string]string{"app.log": "after failed rotation\n"}, dirContents(t, file))
+}
+
+func TestRollingFile_ReopenFailure(t *testing.T) {
+	file, _ := openTestFile(t)
+	write(t, file, "before logrotate")
+	require.NoError(t, os.Rename(file.path, file.path+".1"))
+	require.NoError(t, os.Mkdir(file.path, 0o755))
+
+	assert.Error(t, file.Reopen())
+	write(t, file, "after failed reopen")
+	require.NoError(t, file.Close())
+
+	require.NoError(t, os.Remove(file.path))
+	assert.Equal(t, map[string]string{"app.log.1": "before logrotate\nafter failed reopen\n"}, dirContents(t, file))
+}
+
+func TestRollingFile_ConcurrentWriters(t *testing.T) {
+	file, clock := openTestFile(t, WithMaxSize(1000), WithCompression(), WithMaxFiles(1000))
+	const goroutines, lines = 8, 200
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < lines; i++ {
+				_, _ = fmt.Fprintf(file, "goroutine %d line %d\n", g, i)
+				clock.Advance(time.Millisecond)
+			}
+		}(g)
+	}
+	wg.Wait()
+	require.NoError(t, file.Close())
+
+	count := 0
+	for name, content := range dirContents(t, file) {
+		assert.LessOrEqual(t, len(content), 1000, name)
+		for _, line := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+			assert.Regexp(t, `^goroutine \d line \d+$`, line)
+			count++
+		}
+	}
+	assert.Equal(t, goroutines*lines, count)
+}
+
+func TestRollingFile_SharedByLoggers(t *testing.T) {
+	file, _ := openTestFile(t, WithMaxSize(200))
+	logrusLogger := logrus.New()
+	logrusLogger.SetOutput(file)
+	logrusLogger.SetFormatter(&logrus.TextFormatter{DisableTimestamp: true})
+
+	slf4go_logrus_provider.New(logrusLogger).ForComponent("billing").Infof("charging order %d", 42)
+	slf4go_native_provider.New(file).ForComponent("shipping").Infof("shipping order %d", 42)
+	require.NoError(t, file.Close())
+
+	var all string
+	for _, content := range dirContents(t, file) {
+		all += content
+	}
+	assert.Contains(t, all, `level=info msg="charging order 42" appComponent=billing`)
+	assert.Contains(t, all, `level=info msg="shipping order 42" appComponent=shipping`)
+}
+
+func TestRollingFile_Closed(t *testing.T) {
+	file, _ := openTestFile(t)
+	require.NoError(t, file.Close())
+
+	_, err := file.Write([]byte("discarded\n"))
+	assert.ErrorIs(t, err, os.ErrClosed)
+	assert.ErrorIs(t, file.Rotate(), os.ErrClosed)
+	assert.ErrorIs(t, file.Reopen(), os.ErrClosed)
+	assert.NoError(t, file.Close())
+}
@@ -1,8 +1,12 @@
 package slf4go_logrus_provider
 
 import (
+	"context"
+	"fmt"
 	"github.com/MariusSchmidt/slf4go/slf4go_api"
 	"github.com/sirupsen/logrus"
+	"os"
+	"reflect"
 )
 
 type Slf4GoLogrusLogger struct {
@@ -10,6 +14,14 @@ type Slf4GoLogrusLogger struct {
 	appComponent      slf4go_api.AppComponent
 	tags              slf4go_api.LogTags
 	componentTagLabel string
+	err               error
+	reportCaller      bool
+	stackTraceLevel   slf4go_api.LogLevel
+	componentLevels   *slf4go_api.ComponentLevels
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(Slf4GoLogrusLogger{}).PkgPath())
 }
 
 // New creates a new slf4GoLogrusLogger with optional configurations
@@ -19,15 +31,30 @@ func New(logrusLogger *logrus.Logger) *Slf4GoLogrusLogger {
 		appComponent:      "",
 		tags:              make(slf4go_api.LogTags),
 		componentTagLabel: slf4go_api.DefaultAppComponentTag,
+		stackTraceLevel:   slf4go_api.NoStackTrace,
 	}
 }
 
+// NewProvider creates a slf4go_api.Provider handing out loggers writing to the given logrus.Logger,
+// to be installed via slf4go_api.RegisterProvider.
+func NewProvider(logrusLogger *logrus.Logger) slf4go_api.Provider {
+	root := New(logrusLogger)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
 func (l *Slf4GoLogrusLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
 	return &Slf4GoLogrusLogger{
 		logger:            l.logger,
 		appComponent:      component,
 		tags:              l.tags,
 		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
 	}
 }
 
@@ -37,6 +64,10 @@ func (l *Slf4GoLogrusLogger) WithAppComponentLabel(componentTagLabel string) slf
 		appComponent:      l.appComponent,
 		tags:              l.tags,
 		componentTagLabel: componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
 	}
 }
 
@@ -46,6 +77,94 @@ func (l *Slf4GoLogrusLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.
 		appComponent:      l.appComponent,
 		tags:              tags,
 		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *Slf4GoLogrusLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *Slf4GoLogrusLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return &Slf4GoLogrusLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoLogrusLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return &Slf4GoLogrusLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      enabled,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoLogrusLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return &Slf4GoLogrusLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   threshold,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoLogrusLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	return &Slf4GoLogrusLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   levels,
+	}
+}
+
+func (l *Slf4GoLogrusLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	logrusLevel, ok := toLogrusLevel(level)
+	return ok && l.logger.IsLevelEnabled(logrusLevel) && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *Slf4GoLogrusLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *Slf4GoLogrusLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+// Level returns the level of the underlying logrus.Logger.
+func (l *Slf4GoLogrusLogger) Level() slf4go_api.LogLevel {
+	return fromLogrusLevel(l.logger.GetLevel())
+}
+
+// SetLevel changes the level of the underlying logrus.Logger, affecting all loggers sharing it.
+// Together with Level, it allows changing the backend level via slf4go_admin.WithBackendLevel.
+func (l *Slf4GoLogrusLogger) SetLevel(level slf4go_api.LogLevel) {
+	if logrusLevel, ok := toLogrusLevel(level); ok {
+		l.logger.SetLevel(logrusLevel)
 	}
 }
 
@@ -53,10 +172,12 @@ func (l *Slf4GoLogrusLogger) Logf(level slf4go_api.LogLevel, msgTemplate string,
 	l.LogWithTagsf(level, slf4go_api.LogTags{}, msgTemplate, args...)
 }
 
-func (l *Slf4GoLogrusLogger) logrusLogf(level logrus.Level, msgTemplate string, args ...interface{}) {
+func (l *Slf4GoLogrusLogger) logrusLogf(ctx context.Context, level logrus.Level, msgTemplate string, args ...interface{}) {
 	switch level {
 	case logrus.FatalLevel:
-		l.logger.Fatalf(msgTemplate, args...)
+		// logged without logrus terminating the program, which is left to slf4go_api.ExitWithCtx
+		l.logger.Logf(logrus.FatalLevel, msgTemplate, args...)
+		l.exit(ctx, fmt.Sprintf(msgTemplate, args...))
 	case logrus.PanicLevel:
 		l.logger.Panicf(msgTemplate, args...)
 	case logrus.ErrorLevel:
@@ -72,11 +193,26 @@ func (l *Slf4GoLogrusLogger) logrusLogf(level logrus.Level, msgTemplate string,
 	}
 }
 
-func (l *Slf4GoLogrusLogger) logrusLogWithTagsf(level logrus.Level, fields logrus.Fields, msgTemplate string, args ...interface{}) {
+// exit terminates the program after a Fatal entry like logrus does: the handlers registered via
+// logrus.RegisterExitHandler run after the shutdown hooks, then the ExitFunc of the logrus.Logger terminates the
+// program if it was changed from os.Exit. Otherwise, the exit function set via slf4go_api.SetExitFunc does.
+func (l *Slf4GoLogrusLogger) exit(ctx context.Context, message string) {
+	slf4go_api.ExitWithCtx(ctx, message, func(code int, exitFunc func(code int)) {
+		exiting := &logrus.Logger{ExitFunc: exitFunc}
+		if custom := l.logger.ExitFunc; custom != nil && reflect.ValueOf(custom).Pointer() != reflect.ValueOf(os.Exit).Pointer() {
+			exiting.ExitFunc = custom
+		}
+		// runs the exit handlers before calling ExitFunc
+		exiting.Exit(code)
+	})
+}
+
+func (l *Slf4GoLogrusLogger) logrusLogWithTagsf(ctx context.Context, level logrus.Level, fields logrus.Fields, msgTemplate string, args ...interface{}) {
 	entry := l.logger.WithFields(fields)
 	switch level {
 	case logrus.FatalLevel:
-		entry.Fatalf(msgTemplate, args...)
+		entry.Logf(logrus.FatalLevel, msgTemplate, args...)
+		l.exit(ctx, fmt.Sprintf(msgTemplate, args...))
 	case logrus.PanicLevel:
 		entry.Panicf(msgTemplate, args...)
 	case logrus.ErrorLevel:
@@ -93,24 +229,108 @@ func (l *Slf4GoLogrusLogger) logrusLogWithTagsf(level logrus.Level, fields logru
 }
 
 func (l *Slf4GoLogrusLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
-	logrusLevel, err := logrus.ParseLevel(level.Stringer())
-	if err != nil {
-		l.logger.Errorf("Mapping error level '%s' onto Logrus error level failed. Not logging event", level.Stringer())
+	l.logrusLogWithTagsCtxf(context.Background(), level, tags, msgTemplate, args...)
+}
+
+// logrusLogWithTagsCtxf writes the entry logged with the given context, whose tags are already part of tags.
+// The context is only passed on to slf4go_api.ExitWithCtx.
+func (l *Slf4GoLogrusLogger) logrusLogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	logrusLevel, ok := toLogrusLevel(level)
+	if !ok {
+		l.logger.Errorf("Mapping error level '%s' onto Logrus error level failed. Not logging event", level.String())
+		return
+	}
+	// Fatal and Panic entries are always passed on to terminate the program or panic
+	if logrusLevel > logrus.FatalLevel && !l.IsEnabled(level) {
 		return
 	}
-	tags = combineTags(l.tags, tags)
+	if l.reportCaller {
+		tags = combineTags(slf4go_api.CallerTags(), tags)
+	}
+	if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+		tags = combineTags(slf4go_api.StackTraceTags(), tags)
+	}
+	tags = slf4go_api.ResolveTags(combineTags(l.tags, l.errorTags(), tags))
+	args = slf4go_api.ResolveArgs(args)
 	if len(l.appComponent) == 0 && len(tags) == 0 {
-		l.logrusLogf(logrusLevel, msgTemplate, args...)
+		l.logrusLogf(ctx, logrusLevel, msgTemplate, args...)
 	}
 	if len(l.appComponent) == 0 && len(tags) >= 1 {
-		l.logrusLogWithTagsf(logrusLevel, logrus.Fields(tags), msgTemplate, args...)
+		l.logrusLogWithTagsf(ctx, logrusLevel, logrus.Fields(tags), msgTemplate, args...)
 	}
 	if len(l.appComponent) >= 1 && len(tags) == 0 {
-		l.logrusLogWithTagsf(logrusLevel, logrus.Fields{l.componentTagLabel: l.appComponent}, msgTemplate, args...)
+		l.logrusLogWithTagsf(ctx, logrusLevel, logrus.Fields{l.componentTagLabel: l.appComponent}, msgTemplate, args...)
 	}
 	if len(l.appComponent) >= 1 && len(tags) >= 1 {
 		tagsAsLogrusFields := combineTags(tags, slf4go_api.LogTags{l.componentTagLabel: l.appComponent})
-		l.logrusLogWithTagsf(logrusLevel, logrus.Fields(tagsAsLogrusFields), msgTemplate, args...)
+		l.logrusLogWithTagsf(ctx, logrusLevel, logrus.Fields(tagsAsLogrusFields), msgTemplate, args...)
+	}
+}
+
+func (l *Slf4GoLogrusLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+// errorTags maps the attached error onto logrus.ErrorKey, just like logrus.Entry.WithError does, and adds
+// the chain of wrapped errors under slf4go_api.ErrorChainTag.
+func (l *Slf4GoLogrusLogger) errorTags() slf4go_api.LogTags {
+	if l.err == nil {
+		return slf4go_api.LogTags{}
+	}
+	tags := slf4go_api.ErrorTags(l.err)
+	delete(tags, slf4go_api.ErrorTag)
+	tags[logrus.ErrorKey] = l.err
+	return tags
+}
+
+func (l *Slf4GoLogrusLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, level, slf4go_api.LogTags{}, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if logrusLevel, ok := toLogrusLevel(level); ok && logrusLevel > logrus.FatalLevel && !l.IsEnabled(level) {
+		return
+	}
+	l.logrusLogWithTagsCtxf(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+func toLogrusLevel(level slf4go_api.LogLevel) (logrus.Level, bool) {
+	switch level {
+	case slf4go_api.Fatal:
+		return logrus.FatalLevel, true
+	case slf4go_api.Panic:
+		return logrus.PanicLevel, true
+	case slf4go_api.Error:
+		return logrus.ErrorLevel, true
+	case slf4go_api.Warn:
+		return logrus.WarnLevel, true
+	case slf4go_api.Info:
+		return logrus.InfoLevel, true
+	case slf4go_api.Debug:
+		return logrus.DebugLevel, true
+	case slf4go_api.Trace:
+		return logrus.TraceLevel, true
+	default:
+		return 0, false
+	}
+}
+
+func fromLogrusLevel(level logrus.Level) slf4go_api.LogLevel {
+	switch level {
+	case logrus.FatalLevel:
+		return slf4go_api.Fatal
+	case logrus.PanicLevel:
+		return slf4go_api.Panic
+	case logrus.ErrorLevel:
+		return slf4go_api.Error
+	case logrus.WarnLevel:
+		return slf4go_api.Warn
+	case logrus.InfoLevel:
+		return slf4go_api.Info
+	case logrus.DebugLevel:
+		return slf4go_api.Debug
+	default:
+		return slf4go_api.Trace
 	}
 }
 
@@ -187,3 +407,87 @@ func (l *Slf4GoLogrusLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTempla
 func (l *Slf4GoLogrusLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
 	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
 }
+
+func (l *Slf4GoLogrusLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoLogrusLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
@@ -0,0 +1,163 @@
+package slf4go_logrus_provider_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_logrus_provider"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// The caller tests live in an external test package, as frames of the provider package itself are skipped
+// when determining the call site.
+
+func TestCallerReporting(t *testing.T) {
+	logrusLogger, hook := test.NewNullLogger()
+	logrusLogger.SetLevel(logrus.TraceLevel)
+	root := slf4go_logrus_provider.New(logrusLogger).WithCallerReporting(true)
+	var logger slf4go_api.Slf4GoLogger
+	ctx := context.Background()
+	tags := slf4go_api.LogTags{"key1": "val1"}
+	err := errors.New("test error")
+
+	scenarios := []struct {
+		name  string
+		logFn func()
+		line  int
+	}{
+		{"Logf", func() { logger.Logf(slf4go_api.Info, "test message") }, currentLine()},
+		{"LogWithTagsf", func() { logger.LogWithTagsf(slf4go_api.Info, tags, "test message") }, currentLine()},
+		{"Tracef", func() { logger.Tracef("test message") }, currentLine()},
+		{"Debugf", func() { logger.Debugf("test message") }, currentLine()},
+		{"Infof", func() { logger.Infof("test message") }, currentLine()},
+		{"Warnf", func() { logger.Warnf("test message") }, currentLine()},
+		{"Warningf", func() { logger.Warningf("test message") }, currentLine()},
+		{"Errorf", func() { logger.Errorf("test message") }, currentLine()},
+		{"Panicf", func() { logger.Panicf("test message") }, currentLine()},
+		{"Fatalf", func() { logger.Fatalf("test message") }, currentLine()},
+		{"TraceWithTagsf", func() { logger.TraceWithTagsf(tags, "test message") }, currentLine()},
+		{"DebugWithTagsf", func() { logger.DebugWithTagsf(tags, "test message") }, currentLine()},
+		{"InfoWithTagsf", func() { logger.InfoWithTagsf(tags, "test message") }, currentLine()},
+		{"WarnWithTagsf", func() { logger.WarnWithTagsf(tags, "test message") }, currentLine()},
+		{"WarningWithTagsf", func() { logger.WarningWithTagsf(tags, "test message") }, currentLine()},
+		{"ErrorWithTagsf", func() { logger.ErrorWithTagsf(tags, "test message") }, currentLine()},
+		{"PanicWithTagsf", func() { logger.PanicWithTagsf(tags, "test message") }, currentLine()},
+		{"FatalWithTagsf", func() { logger.FatalWithTagsf(tags, "test message") }, currentLine()},
+		{"LogErrf", func() { logger.LogErrf(slf4go_api.Error, err, "test message") }, currentLine()},
+		{"WarnErrf", func() { logger.WarnErrf(err, "test message") }, currentLine()},
+		{"WarningErrf", func() { logger.WarningErrf(err, "test message") }, currentLine()},
+		{"ErrorErrf", func() { logger.ErrorErrf(err, "test message") }, currentLine()},
+		{"PanicErrf", func() { logger.PanicErrf(err, "test message") }, currentLine()},
+		{"FatalErrf", func() { logger.FatalErrf(err, "test message") }, currentLine()},
+		{"LogCtxf", func() { logger.LogCtxf(ctx, slf4go_api.Info, "test message") }, currentLine()},
+		{"LogWithTagsCtxf", func() { logger.LogWithTagsCtxf(ctx, slf4go_api.Info, tags, "test message") }, currentLine()},
+		{"TraceCtxf", func() { logger.TraceCtxf(ctx, "test message") }, currentLine()},
+		{"DebugCtxf", func() { logger.DebugCtxf(ctx, "test message") }, currentLine()},
+		{"InfoCtxf", func() { logger.InfoCtxf(ctx, "test message") }, currentLine()},
+		{"WarnCtxf", func() { logger.WarnCtxf(ctx, "test message") }, currentLine()},
+		{"WarningCtxf", func() { logger.WarningCtxf(ctx, "test message") }, currentLine()},
+		{"ErrorCtxf", func() { logger.ErrorCtxf(ctx, "test message") }, currentLine()},
+		{"PanicCtxf", func() { logger.PanicCtxf(ctx, "test message") }, currentLine()},
+		{"FatalCtxf", func() { logger.FatalCtxf(ctx, "test message") }, currentLine()},
+		{"TraceWithTagsCtxf", func() { logger.TraceWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"DebugWithTagsCtxf", func() { logger.DebugWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"InfoWithTagsCtxf", func() { logger.InfoWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"WarnWithTagsCtxf", func() { logger.WarnWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"WarningWithTagsCtxf", func() { logger.WarningWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"ErrorWithTagsCtxf", func() { logger.ErrorWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"PanicWithTagsCtxf", func() { logger.PanicWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"FatalWithTagsCtxf", func() { logger.FatalWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			hook.Reset()
+			_ = slf4go_api.CatchFatal(root, func(derived slf4go_api.Slf4GoLogger) {
+				logger = derived
+				defer func() { _ = recover() }()
+				scenario.logFn()
+			})
+			assertCaller(t, hook.LastEntry(), scenario.line)
+		})
+	}
+}
+
+func TestCallerReporting_DerivedLoggers(t *testing.T) {
+	logrusLogger, hook := test.NewNullLogger()
+	logger := slf4go_logrus_provider.New(logrusLogger).WithCallerReporting(true)
+
+	t.Run("derived-loggers-keep-caller-reporting", func(t *testing.T) {
+		logger.ForComponent("test-service").WithStaticTags(slf4go_api.LogTags{"key1": "val1"}).Infof("test message")
+		assertCaller(t, hook.LastEntry(), currentLine()-1)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		slf4go_logrus_provider.New(logrusLogger).Infof("test message")
+		assert.NotContains(t, hook.LastEntry().Data, slf4go_api.CallerTag)
+		logger.WithCallerReporting(false).Infof("test message")
+		assert.NotContains(t, hook.LastEntry().Data, slf4go_api.CallerTag)
+	})
+}
+
+func TestStackTrace(t *testing.T) {
+	logrusLogger, hook := test.NewNullLogger()
+	root := slf4go_logrus_provider.New(logrusLogger).WithStackTrace(slf4go_api.Error)
+	var logger slf4go_api.Slf4GoLogger
+
+	scenarios := []struct {
+		name     string
+		logFn    func()
+		expected bool
+	}{
+		{"fatal", func() { logger.FatalWithTagsf(slf4go_api.LogTags{}, "test message") }, true},
+		{"panic", func() { logger.PanicWithTagsf(slf4go_api.LogTags{}, "test message") }, true},
+		{"error", func() { logger.ErrorWithTagsf(slf4go_api.LogTags{}, "test message") }, true},
+		{"warn", func() { logger.WarnWithTagsf(slf4go_api.LogTags{}, "test message") }, false},
+		{"info", func() { logger.InfoWithTagsf(slf4go_api.LogTags{}, "test message") }, false},
+		{"disabled", func() { logger.WithStackTrace(slf4go_api.NoStackTrace).Errorf("test message") }, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			hook.Reset()
+			_ = slf4go_api.CatchFatal(root, func(derived slf4go_api.Slf4GoLogger) {
+				logger = derived
+				defer func() { _ = recover() }()
+				scenario.logFn()
+			})
+			stackTrace, ok := hook.LastEntry().Data[slf4go_api.StackTraceTag].(slf4go_api.StackTrace)
+			if !scenario.expected {
+				assert.False(t, ok)
+				return
+			}
+			if assert.True(t, ok) && assert.NotEmpty(t, stackTrace) {
+				assert.True(t, strings.HasPrefix(stackTrace[0].Function, "github.com/MariusSchmidt/slf4go/slf4go_logrus_provider_test.TestStackTrace"), stackTrace[0].Function)
+				for _, frame := range stackTrace {
+					assert.False(t, strings.HasPrefix(frame.Function, "github.com/MariusSchmidt/slf4go/slf4go_logrus_provider."), frame.Function)
+					assert.False(t, strings.HasPrefix(frame.Function, "runtime."), frame.Function)
+				}
+			}
+		})
+	}
+}
+
+func assertCaller(t *testing.T, entry *logrus.Entry, line int) {
+	if !assert.NotNil(t, entry) {
+		return
+	}
+	assert.True(t, strings.HasSuffix(entry.Data[slf4go_api.CallerTag].(string), fmt.Sprintf("/slf4go_logrus_provider_caller_test.go:%d", line)),
+		"unexpected caller %v", entry.Data[slf4go_api.CallerTag])
+	assert.True(t, strings.HasPrefix(entry.Data[slf4go_api.FunctionTag].(string), "github.com/MariusSchmidt/slf4go/slf4go_logrus_provider_test.TestCallerReporting"),
+		"unexpected function %v", entry.Data[slf4go_api.FunctionTag])
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
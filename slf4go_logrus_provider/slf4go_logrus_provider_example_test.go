@@ -1,6 +1,7 @@
 package slf4go_logrus_provider
 
 import (
+	"context"
 	"github.com/MariusSchmidt/slf4go/slf4go_api"
 	"github.com/sirupsen/logrus"
 )
@@ -31,3 +32,28 @@ func ExampleSlf4GoLogrusLogger_WithStaticTags() {
 
 	logger.Infof("Processing request...")
 }
+
+func ExampleSlf4GoLogrusLogger_InfoCtxf() {
+	unregister := slf4go_api.RegisterContextTagExtractor(func(ctx context.Context) slf4go_api.LogTags {
+		if requestID, ok := ctx.Value(requestIDKey{}).(string); ok {
+			return slf4go_api.LogTags{"requestID": requestID}
+		}
+		return nil
+	})
+	defer unregister()
+
+	logger := New(logrus.StandardLogger())
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "abc-123")
+	logger.InfoCtxf(ctx, "Processing request...")
+}
+
+type requestIDKey struct{}
+
+func ExampleNewProvider() {
+	// register the provider once during application startup
+	slf4go_api.RegisterProvider(NewProvider(logrus.StandardLogger()))
+
+	// obtain named loggers anywhere, e.g. in libraries
+	logger := slf4go_api.GetLogger("billing.invoice")
+	logger.Infof("Invoice created")
+}
@@ -0,0 +1,47 @@
+package slf4go_logrus_provider
+
+import (
+	"fmt"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFatal_ExitHandling(t *testing.T) {
+	defer slf4go_api.SetExitFunc(nil)
+	var calls []string
+	slf4go_api.SetExitFunc(func(code int) { calls = append(calls, fmt.Sprintf("slf4go exit %d", code)) })
+	handlerActive := true
+	defer func() { handlerActive = false }()
+	logrus.RegisterExitHandler(func() {
+		if handlerActive {
+			calls = append(calls, "logrus handler")
+		}
+	})
+
+	t.Run("default-exit-func", func(t *testing.T) {
+		calls = nil
+		testConfig := newTestingSetup()
+		testConfig.slf4GoLogrusLogger.Fatalf("disk full")
+		assert.Equal(t, []string{"logrus handler", "slf4go exit 1"}, calls)
+	})
+
+	t.Run("custom-exit-func", func(t *testing.T) {
+		calls = nil
+		testConfig := newTestingSetup()
+		testConfig.slf4GoLogrusLogger.logger.ExitFunc = func(code int) { calls = append(calls, fmt.Sprintf("logrus exit %d", code)) }
+		testConfig.slf4GoLogrusLogger.WithStaticTags(slf4go_api.LogTags{"disk": "/var"}).Fatalf("disk full")
+		assert.Equal(t, []string{"logrus handler", "logrus exit 1"}, calls)
+	})
+
+	t.Run("caught", func(t *testing.T) {
+		calls = nil
+		testConfig := newTestingSetup()
+		err := slf4go_api.CatchFatal(testConfig.slf4GoLogrusLogger, func(logger slf4go_api.Slf4GoLogger) {
+			logger.Fatalf("disk full")
+		})
+		assert.Error(t, err)
+		assert.Empty(t, calls)
+	})
+}
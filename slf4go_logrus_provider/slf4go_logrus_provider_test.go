@@ -1,6 +1,9 @@
 package slf4go_logrus_provider
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/MariusSchmidt/slf4go/slf4go_api"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -13,25 +16,25 @@ func TestLogging(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"fatal", testConfig.slf4GoLogrusLogger.Fatalf, testConfig.slf4GoLogrusLogger.FatalWithTagsf, logrus.FatalLevel},
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"fatal", slf4go_api.Slf4GoLogger.Fatalf, slf4go_api.Slf4GoLogger.FatalWithTagsf, logrus.FatalLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -43,8 +46,8 @@ func TestLogging(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -59,8 +62,8 @@ func TestLogging(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -72,8 +75,9 @@ func TestLogging(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -105,24 +109,24 @@ func TestLogging_ForComponent(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -136,8 +140,8 @@ func TestLogging_ForComponent(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -153,8 +157,8 @@ func TestLogging_ForComponent(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -168,8 +172,9 @@ func TestLogging_ForComponent(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -204,24 +209,24 @@ func TestLogging_WithComponentLabel(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -233,8 +238,8 @@ func TestLogging_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -249,8 +254,8 @@ func TestLogging_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -262,8 +267,9 @@ func TestLogging_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -301,24 +307,24 @@ func TestLogging_WithStaticTags(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -333,8 +339,8 @@ func TestLogging_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -351,8 +357,8 @@ func TestLogging_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -367,8 +373,9 @@ func TestLogging_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -408,24 +415,24 @@ func TestLogging_WithComponentLabel_WithStaticTags(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -440,8 +447,8 @@ func TestLogging_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -458,8 +465,8 @@ func TestLogging_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -474,8 +481,9 @@ func TestLogging_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -512,24 +520,24 @@ func TestLogging_ForComponent_WithComponentLabel(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -543,8 +551,8 @@ func TestLogging_ForComponent_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -560,8 +568,8 @@ func TestLogging_ForComponent_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -575,8 +583,9 @@ func TestLogging_ForComponent_WithComponentLabel(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -615,24 +624,24 @@ func TestLogging_ForComponent_WithStaticTags(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -648,8 +657,8 @@ func TestLogging_ForComponent_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -667,8 +676,8 @@ func TestLogging_ForComponent_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -684,8 +693,9 @@ func TestLogging_ForComponent_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -727,24 +737,24 @@ func TestLogging_ForComponent_WithComponentLabel_WithStaticTags(t *testing.T) {
 
 	scenarios := []struct {
 		name          string
-		logFn         func(string, ...interface{})
-		logFnWithTags func(slf4go_api.LogTags, string, ...interface{})
+		logFn         func(slf4go_api.Slf4GoLogger, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, slf4go_api.LogTags, string, ...interface{})
 		logLevel      logrus.Level
 	}{
-		{"panic", testConfig.slf4GoLogrusLogger.Panicf, testConfig.slf4GoLogrusLogger.PanicWithTagsf, logrus.PanicLevel},
-		{"error", testConfig.slf4GoLogrusLogger.Errorf, testConfig.slf4GoLogrusLogger.ErrorWithTagsf, logrus.ErrorLevel},
-		{"warn", testConfig.slf4GoLogrusLogger.Warnf, testConfig.slf4GoLogrusLogger.WarnWithTagsf, logrus.WarnLevel},
-		{"warning", testConfig.slf4GoLogrusLogger.Warningf, testConfig.slf4GoLogrusLogger.WarningWithTagsf, logrus.WarnLevel},
-		{"info", testConfig.slf4GoLogrusLogger.Infof, testConfig.slf4GoLogrusLogger.InfoWithTagsf, logrus.InfoLevel},
-		{"debug", testConfig.slf4GoLogrusLogger.Debugf, testConfig.slf4GoLogrusLogger.DebugWithTagsf, logrus.DebugLevel},
-		{"trace", testConfig.slf4GoLogrusLogger.Tracef, testConfig.slf4GoLogrusLogger.TraceWithTagsf, logrus.TraceLevel},
+		{"panic", slf4go_api.Slf4GoLogger.Panicf, slf4go_api.Slf4GoLogger.PanicWithTagsf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.Errorf, slf4go_api.Slf4GoLogger.ErrorWithTagsf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.Warnf, slf4go_api.Slf4GoLogger.WarnWithTagsf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.Warningf, slf4go_api.Slf4GoLogger.WarningWithTagsf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.Infof, slf4go_api.Slf4GoLogger.InfoWithTagsf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.Debugf, slf4go_api.Slf4GoLogger.DebugWithTagsf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.Tracef, slf4go_api.Slf4GoLogger.TraceWithTagsf, logrus.TraceLevel},
 	}
 
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-base", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -760,8 +770,8 @@ func TestLogging_ForComponent_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFnWithTags(map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(logger, map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"}, "test message")
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -779,8 +789,8 @@ func TestLogging_ForComponent_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
-				scenario.logFn("test message with name=%s and value=%d", "beeblebrox", 42)
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, "test message with name=%s and value=%d", "beeblebrox", 42)
 			})
 			assertLog(t, testConfig.hook).
 				hasLevel(scenario.logLevel).
@@ -796,8 +806,9 @@ func TestLogging_ForComponent_WithComponentLabel_WithStaticTags(t *testing.T) {
 	for _, scenario := range scenarios {
 		t.Run(scenario.name+"-dyntags-formatted", func(t *testing.T) {
 			testConfig.hook.Reset()
-			fatalSafe(t, testConfig, scenario.logLevel, func() {
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
 				scenario.logFnWithTags(
+					logger,
 					map[string]interface{}{"dyn_key1": "dyn_val1", "dyn_key2": "dyn_val2"},
 					"test message with name=%s and value=%d",
 					"beeblebrox",
@@ -828,6 +839,277 @@ func TestLogging_ForComponent_WithComponentLabel_WithStaticTags(t *testing.T) {
 	})
 }
 
+func TestLogging_WithContext(t *testing.T) {
+	testConfig := newTestingSetup().
+		forComponent("test-service").
+		withStaticTags(map[string]interface{}{"key1": "val1", "ctx_key2": "static_val2"})
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"ctx_key1": "ctx_val1", "ctx_key2": "ctx_val2"})
+
+	scenarios := []struct {
+		name          string
+		logFn         func(slf4go_api.Slf4GoLogger, context.Context, string, ...interface{})
+		logFnWithTags func(slf4go_api.Slf4GoLogger, context.Context, slf4go_api.LogTags, string, ...interface{})
+		logLevel      logrus.Level
+	}{
+		{"fatal", slf4go_api.Slf4GoLogger.FatalCtxf, slf4go_api.Slf4GoLogger.FatalWithTagsCtxf, logrus.FatalLevel},
+		{"panic", slf4go_api.Slf4GoLogger.PanicCtxf, slf4go_api.Slf4GoLogger.PanicWithTagsCtxf, logrus.PanicLevel},
+		{"error", slf4go_api.Slf4GoLogger.ErrorCtxf, slf4go_api.Slf4GoLogger.ErrorWithTagsCtxf, logrus.ErrorLevel},
+		{"warn", slf4go_api.Slf4GoLogger.WarnCtxf, slf4go_api.Slf4GoLogger.WarnWithTagsCtxf, logrus.WarnLevel},
+		{"warning", slf4go_api.Slf4GoLogger.WarningCtxf, slf4go_api.Slf4GoLogger.WarningWithTagsCtxf, logrus.WarnLevel},
+		{"info", slf4go_api.Slf4GoLogger.InfoCtxf, slf4go_api.Slf4GoLogger.InfoWithTagsCtxf, logrus.InfoLevel},
+		{"debug", slf4go_api.Slf4GoLogger.DebugCtxf, slf4go_api.Slf4GoLogger.DebugWithTagsCtxf, logrus.DebugLevel},
+		{"trace", slf4go_api.Slf4GoLogger.TraceCtxf, slf4go_api.Slf4GoLogger.TraceWithTagsCtxf, logrus.TraceLevel},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name+"-ctx", func(t *testing.T) {
+			testConfig.hook.Reset()
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFn(logger, ctx, "test message with name=%s and value=%d", "beeblebrox", 42)
+			})
+			assertLog(t, testConfig.hook).
+				hasLevel(scenario.logLevel).
+				hasTags(map[string]interface{}{
+					"key1":                            "val1",
+					"ctx_key1":                        "ctx_val1",
+					"ctx_key2":                        "ctx_val2",
+					slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("test-service"),
+				}).
+				hasMessage("test message with name=beeblebrox and value=42")
+		})
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name+"-dyntags-ctx", func(t *testing.T) {
+			testConfig.hook.Reset()
+			fatalSafe(t, testConfig, scenario.logLevel, func(logger slf4go_api.Slf4GoLogger) {
+				scenario.logFnWithTags(
+					logger,
+					ctx,
+					map[string]interface{}{"dyn_key1": "dyn_val1", "ctx_key1": "dyn_val2"},
+					"test message with name=%s and value=%d",
+					"beeblebrox",
+					42,
+				)
+			})
+			assertLog(t, testConfig.hook).
+				hasLevel(scenario.logLevel).
+				hasTags(map[string]interface{}{
+					"key1":                            "val1",
+					"dyn_key1":                        "dyn_val1",
+					"ctx_key1":                        "dyn_val2",
+					"ctx_key2":                        "ctx_val2",
+					slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("test-service"),
+				}).
+				hasMessage("test message with name=beeblebrox and value=42")
+		})
+	}
+
+	t.Run("nil-context", func(t *testing.T) {
+		testConfig.hook.Reset()
+		//lint:ignore SA1012 a nil context must be tolerated
+		testConfig.slf4GoLogrusLogger.LogCtxf(nil, slf4go_api.Info, "test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.InfoLevel).
+			hasTags(map[string]interface{}{
+				"key1":                            "val1",
+				"ctx_key2":                        "static_val2",
+				slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("test-service"),
+			}).
+			hasMessage("test message")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		testConfig.hook.Reset()
+		var unknownLevel slf4go_api.LogLevel = 666
+		testConfig.slf4GoLogrusLogger.LogCtxf(ctx, unknownLevel, "Some message not displayed")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.ErrorLevel).
+			hasTags(map[string]interface{}{}).
+			hasMessage("Mapping error level 'unknown' onto Logrus error level failed. Not logging event")
+	})
+}
+
+func TestIsEnabled(t *testing.T) {
+	testConfig := newTestingSetup()
+	testConfig.slf4GoLogrusLogger.logger.SetLevel(logrus.InfoLevel)
+
+	scenarios := []struct {
+		name     string
+		level    slf4go_api.LogLevel
+		expected bool
+	}{
+		{"fatal", slf4go_api.Fatal, true},
+		{"panic", slf4go_api.Panic, true},
+		{"error", slf4go_api.Error, true},
+		{"warn", slf4go_api.Warn, true},
+		{"info", slf4go_api.Info, true},
+		{"debug", slf4go_api.Debug, false},
+		{"trace", slf4go_api.Trace, false},
+		{"unknown", 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, testConfig.slf4GoLogrusLogger.IsEnabled(scenario.level))
+		})
+	}
+
+	t.Run("debug-and-trace", func(t *testing.T) {
+		assert.False(t, testConfig.slf4GoLogrusLogger.IsDebugEnabled())
+		assert.False(t, testConfig.slf4GoLogrusLogger.IsTraceEnabled())
+	})
+
+	t.Run("disabled-entries-are-dropped", func(t *testing.T) {
+		testConfig.hook.Reset()
+		testConfig.slf4GoLogrusLogger.DebugWithTagsf(slf4go_api.LogTags{"key1": "val1"}, "test message")
+		testConfig.slf4GoLogrusLogger.TraceCtxf(context.Background(), "test message")
+		assert.Empty(t, testConfig.hook.AllEntries())
+	})
+}
+
+func TestLogging_Lazy(t *testing.T) {
+	testConfig := newTestingSetup()
+	testConfig.slf4GoLogrusLogger.logger.SetLevel(logrus.InfoLevel)
+	evaluations := 0
+	lazyArg := slf4go_api.Lazy(func() interface{} {
+		evaluations++
+		return "beeblebrox"
+	})
+	lazyTags := slf4go_api.LogTags{"lazy": slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags {
+		evaluations++
+		return slf4go_api.LogTags{"lazy_key1": "lazy_val1", "dyn_key1": "overridden"}
+	})}
+	lazyValue := slf4go_api.Lazy(func() interface{} {
+		evaluations++
+		return "lazy_val2"
+	})
+	logger := testConfig.slf4GoLogrusLogger.WithStaticTags(slf4go_api.LogTags{"lazy_key2": lazyValue})
+
+	t.Run("disabled", func(t *testing.T) {
+		evaluations = 0
+		logger.DebugWithTagsf(combineTags(lazyTags, slf4go_api.LogTags{"dyn_key1": "dyn_val1"}), "test message with name=%s", lazyArg)
+		logger.TraceCtxf(context.Background(), "test message with name=%s", lazyArg)
+		assert.Equal(t, 0, evaluations)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		evaluations = 0
+		testConfig.hook.Reset()
+		logger.InfoWithTagsf(combineTags(lazyTags, slf4go_api.LogTags{"dyn_key1": "dyn_val1"}), "test message with name=%s", lazyArg)
+		assert.Equal(t, 3, evaluations)
+		assertLog(t, testConfig.hook).
+			hasTags(map[string]interface{}{
+				"dyn_key1":  "dyn_val1",
+				"lazy_key1": "lazy_val1",
+				"lazy_key2": "lazy_val2",
+			}).
+			hasMessage("test message with name=beeblebrox")
+	})
+}
+
+func TestLogging_WithError(t *testing.T) {
+	rootCause := errors.New("connection refused")
+	err := fmt.Errorf("query failed: %w", rootCause)
+
+	t.Run("with-error", func(t *testing.T) {
+		testConfig := newTestingSetup().forComponent("test-service").withStaticTags(map[string]interface{}{"key1": "val1"})
+		testConfig.slf4GoLogrusLogger.WithError(err).InfoWithTagsf(slf4go_api.LogTags{"key2": "val2"}, "test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.InfoLevel).
+			hasTags(map[string]interface{}{
+				"key1":                            "val1",
+				"key2":                            "val2",
+				logrus.ErrorKey:                   err,
+				slf4go_api.ErrorChainTag:          []string{"query failed: connection refused", "connection refused"},
+				slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("test-service"),
+			}).
+			hasMessage("test message")
+	})
+
+	t.Run("errf", func(t *testing.T) {
+		testConfig := newTestingSetup()
+		testConfig.slf4GoLogrusLogger.ErrorErrf(rootCause, "test message with name=%s", "beeblebrox")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.ErrorLevel).
+			hasTags(map[string]interface{}{logrus.ErrorKey: rootCause}).
+			hasMessage("test message with name=beeblebrox")
+	})
+
+	t.Run("derived-loggers-keep-error", func(t *testing.T) {
+		testConfig := newTestingSetup()
+		testConfig.slf4GoLogrusLogger.WithError(rootCause).ForComponent("test-service").Warnf("test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.WarnLevel).
+			hasTags(map[string]interface{}{
+				logrus.ErrorKey:                   rootCause,
+				slf4go_api.DefaultAppComponentTag: slf4go_api.AppComponent("test-service"),
+			})
+	})
+
+	t.Run("nil-error", func(t *testing.T) {
+		testConfig := newTestingSetup()
+		testConfig.slf4GoLogrusLogger.WarningErrf(nil, "test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.WarnLevel).
+			hasTags(map[string]interface{}{})
+	})
+}
+
+func TestComponentLevels(t *testing.T) {
+	testConfig := newTestingSetup()
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	levels.SetLevel("payment-gateway", slf4go_api.Trace)
+	logger := testConfig.slf4GoLogrusLogger.WithComponentLevels(levels)
+
+	t.Run("default-level", func(t *testing.T) {
+		testConfig.hook.Reset()
+		componentLogger := logger.ForComponent("user-service")
+		assert.False(t, componentLogger.IsDebugEnabled())
+		componentLogger.DebugWithTagsf(slf4go_api.LogTags{"key1": "val1"}, "test message")
+		componentLogger.TraceCtxf(context.Background(), "test message")
+		assert.Empty(t, testConfig.hook.AllEntries())
+	})
+
+	t.Run("override", func(t *testing.T) {
+		testConfig.hook.Reset()
+		componentLogger := logger.ForComponent("payment-gateway")
+		assert.True(t, componentLogger.IsTraceEnabled())
+		componentLogger.Tracef("test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.TraceLevel).
+			hasMessage("test message")
+	})
+
+	t.Run("changed-at-runtime", func(t *testing.T) {
+		testConfig.hook.Reset()
+		levels.SetLevel("user-service", slf4go_api.Debug)
+		logger.ForComponent("user-service").Debugf("test message")
+		assertLog(t, testConfig.hook).
+			hasLevel(logrus.DebugLevel).
+			hasMessage("test message")
+	})
+}
+
+func TestBackendLevel(t *testing.T) {
+	testConfig := newTestingSetup()
+	logger := testConfig.slf4GoLogrusLogger
+
+	for _, level := range slf4go_api.AllLevels {
+		t.Run(level.String(), func(t *testing.T) {
+			logger.SetLevel(level)
+			assert.Equal(t, level, logger.Level())
+		})
+	}
+
+	t.Run("shared-by-derived-loggers", func(t *testing.T) {
+		logger.SetLevel(slf4go_api.Info)
+		assert.False(t, logger.ForComponent("payment-gateway").IsDebugEnabled())
+		logger.SetLevel(slf4go_api.Debug)
+		assert.True(t, logger.ForComponent("payment-gateway").IsDebugEnabled())
+	})
+}
+
 type testingSetup struct {
 	slf4GoLogrusLogger *Slf4GoLogrusLogger
 	hook               *test.Hook
@@ -837,7 +1119,6 @@ func newTestingSetup() *testingSetup {
 	var logrusLogger *logrus.Logger
 	var hook *test.Hook
 	logrusLogger, hook = test.NewNullLogger()
-	logrusLogger.ExitFunc = func(int) {}
 	logrusLogger.SetLevel(logrus.TraceLevel)
 	return &testingSetup{
 		slf4GoLogrusLogger: New(logrusLogger),
@@ -860,20 +1141,13 @@ func (setup *testingSetup) withStaticTags(tags map[string]interface{}) *testingS
 	return setup
 }
 
-func fatalSafe(t *testing.T, setup *testingSetup, logLevel logrus.Level, logFn func()) {
+func fatalSafe(t *testing.T, setup *testingSetup, logLevel logrus.Level, logFn func(logger slf4go_api.Slf4GoLogger)) {
 	if logLevel == logrus.FatalLevel {
-		origExit := setup.slf4GoLogrusLogger.logger.ExitFunc
-		called := false
-		setup.slf4GoLogrusLogger.logger.ExitFunc = func(code int) {
-			called = true
-		}
-		logFn()
-		assert.True(t, called, "exitFunc should have been called")
-		setup.slf4GoLogrusLogger.logger.ExitFunc = origExit // restore
+		assert.IsType(t, &slf4go_api.FatalError{}, slf4go_api.CatchFatal(setup.slf4GoLogrusLogger, logFn), "Fatal entry should have terminated the program")
 	} else if logLevel == logrus.PanicLevel {
-		assert.Panics(t, logFn)
+		assert.Panics(t, func() { logFn(setup.slf4GoLogrusLogger) })
 	} else {
-		logFn()
+		logFn(setup.slf4GoLogrusLogger)
 	}
 }
 
@@ -0,0 +1,168 @@
+package slf4go_native_provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Keys of the fields every entry consists of. Tags with one of these keys are rendered with the
+// prefix "fields." so that they cannot overwrite the built-in fields.
+const (
+	TimeKey    = "time"
+	LevelKey   = "level"
+	MessageKey = "msg"
+)
+
+// Entry is a single log entry as passed to an Encoder.
+type Entry struct {
+	Time    time.Time
+	Level   slf4go_api.LogLevel
+	Message string
+	// Tags contains the static tags, the dynamic tags and the component of the entry.
+	Tags slf4go_api.LogTags
+}
+
+// Encoder serializes log entries into a buffer. Implementations must terminate every entry with a newline.
+type Encoder interface {
+	Encode(buffer *bytes.Buffer, entry Entry) error
+}
+
+// TextEncoder serializes entries in logfmt, e.g. `time=2024-01-02T15:04:05.999Z level=info msg="Server starting" port=8080`.
+// Tags are sorted by key. Values containing whitespace, quotes, '=' or non-printable characters are quoted, these
+// characters are replaced by '_' in keys, which cannot be quoted.
+type TextEncoder struct{}
+
+// Encode implements Encoder.
+func (e TextEncoder) Encode(buffer *bytes.Buffer, entry Entry) error {
+	writeTextField(buffer, TimeKey, entry.Time.Format(time.RFC3339Nano))
+	buffer.WriteByte(' ')
+	writeTextField(buffer, LevelKey, entry.Level.String())
+	buffer.WriteByte(' ')
+	writeTextField(buffer, MessageKey, entry.Message)
+	for _, key := range sortedKeys(entry.Tags) {
+		buffer.WriteByte(' ')
+		writeTextField(buffer, fieldKey(key), formatValue(entry.Tags[key]))
+	}
+	buffer.WriteByte('\n')
+	return nil
+}
+
+// JSONEncoder serializes entries as one JSON object per line. Tags are sorted by key and follow the
+// built-in fields. Values that cannot be marshalled are rendered via fmt.
+type JSONEncoder struct{}
+
+// Encode implements Encoder.
+func (e JSONEncoder) Encode(buffer *bytes.Buffer, entry Entry) error {
+	buffer.WriteByte('{')
+	writeJSONField(buffer, TimeKey, entry.Time.Format(time.RFC3339Nano))
+	buffer.WriteByte(',')
+	writeJSONField(buffer, LevelKey, entry.Level.String())
+	buffer.WriteByte(',')
+	writeJSONField(buffer, MessageKey, entry.Message)
+	for _, key := range sortedKeys(entry.Tags) {
+		buffer.WriteByte(',')
+		writeJSONField(buffer, fieldKey(key), jsonValue(entry.Tags[key]))
+	}
+	buffer.WriteString("}\n")
+	return nil
+}
+
+func writeTextField(buffer *bytes.Buffer, key string, value string) {
+	buffer.WriteString(textKey(key))
+	buffer.WriteByte('=')
+	if needsQuoting(value) {
+		buffer.WriteString(strconv.Quote(value))
+	} else {
+		buffer.WriteString(value)
+	}
+}
+
+func writeJSONField(buffer *bytes.Buffer, key string, value interface{}) {
+	encodedKey, _ := json.Marshal(key)
+	buffer.Write(encodedKey)
+	buffer.WriteByte(':')
+	encodedValue, err := json.Marshal(value)
+	if err != nil {
+		encodedValue, _ = json.Marshal(fmt.Sprintf("%+v", value))
+	}
+	buffer.Write(encodedValue)
+}
+
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case error:
+		return v.Error()
+	case slf4go_api.AppComponent:
+		return string(v)
+	default:
+		return value
+	}
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprintf("%+v", value)
+	}
+}
+
+// textKey replaces the characters of key that would need quoting by '_', an empty key by "_".
+func textKey(key string) string {
+	if !needsQuoting(key) {
+		return key
+	}
+	if key == "" {
+		return "_"
+	}
+	return strings.Map(func(r rune) rune {
+		if needsQuoting(string(r)) {
+			return '_'
+		}
+		return r
+	}, key)
+}
+
+func needsQuoting(value string) bool {
+	if value == "" {
+		return true
+	}
+	for _, r := range value {
+		if r == '=' || r == '"' || r == utf8.RuneError || unicode.IsSpace(r) || !unicode.IsPrint(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldKey(key string) string {
+	switch key {
+	case TimeKey, LevelKey, MessageKey:
+		return "fields." + key
+	default:
+		return key
+	}
+}
+
+func sortedKeys(tags slf4go_api.LogTags) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
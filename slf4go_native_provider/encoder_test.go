@@ -0,0 +1,105 @@
+package slf4go_native_provider
+
+import (
+	"bytes"
+	"errors"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var testTime = time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC)
+
+func TestTextEncoder(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		entry    Entry
+		expected string
+	}{
+		{
+			"base",
+			Entry{Time: testTime, Level: slf4go_api.Info, Message: "Server", Tags: slf4go_api.LogTags{}},
+			"time=2024-01-02T15:04:05.123456789Z level=info msg=Server\n",
+		},
+		{
+			"quoted",
+			Entry{Time: testTime, Level: slf4go_api.Warn, Message: "Server starting", Tags: slf4go_api.LogTags{
+				"empty": "", "equals": "a=b", "quote": `say "hi"`, "newline": "a\nb",
+			}},
+			`time=2024-01-02T15:04:05.123456789Z level=warning msg="Server starting" empty="" equals="a=b" newline="a\nb" quote="say \"hi\""` + "\n",
+		},
+		{
+			"sorted-tags",
+			Entry{Time: testTime, Level: slf4go_api.Error, Message: "failed", Tags: slf4go_api.LogTags{
+				"c_key": 42, "a_key": errors.New("boom"), "b_key": slf4go_api.AppComponent("service"), "d_key": time.Second,
+			}},
+			"time=2024-01-02T15:04:05.123456789Z level=error msg=failed a_key=boom b_key=service c_key=42 d_key=1s\n",
+		},
+		{
+			"reserved-keys",
+			Entry{Time: testTime, Level: slf4go_api.Debug, Message: "msg", Tags: slf4go_api.LogTags{
+				"time": "t", "level": "l", "msg": "m",
+			}},
+			"time=2024-01-02T15:04:05.123456789Z level=debug msg=msg fields.level=l fields.msg=m fields.time=t\n",
+		},
+		{
+			"sanitized-keys",
+			Entry{Time: testTime, Level: slf4go_api.Info, Message: "msg", Tags: slf4go_api.LogTags{
+				"user name": "a", "a=b": "b", `say "hi"`: "c", "": "d", "tab\tkey": "e",
+			}},
+			"time=2024-01-02T15:04:05.123456789Z level=info msg=msg _=d a_b=b say__hi_=c tab_key=e user_name=a\n",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			assert.NoError(t, TextEncoder{}.Encode(&buffer, scenario.entry))
+			assert.Equal(t, scenario.expected, buffer.String())
+		})
+	}
+}
+
+func TestJSONEncoder(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		entry    Entry
+		expected string
+	}{
+		{
+			"base",
+			Entry{Time: testTime, Level: slf4go_api.Info, Message: "Server starting", Tags: slf4go_api.LogTags{}},
+			`{"time":"2024-01-02T15:04:05.123456789Z","level":"info","msg":"Server starting"}` + "\n",
+		},
+		{
+			"sorted-tags",
+			Entry{Time: testTime, Level: slf4go_api.Error, Message: "failed", Tags: slf4go_api.LogTags{
+				"c_key": 42, "a_key": errors.New("boom"), "b_key": slf4go_api.AppComponent("service"), "d_key": []string{"x"},
+			}},
+			`{"time":"2024-01-02T15:04:05.123456789Z","level":"error","msg":"failed","a_key":"boom","b_key":"service","c_key":42,"d_key":["x"]}` + "\n",
+		},
+		{
+			"unsupported-value",
+			Entry{Time: testTime, Level: slf4go_api.Trace, Message: "msg", Tags: slf4go_api.LogTags{"fn": func() {}, "ch": make(chan int)}},
+			"",
+		},
+		{
+			"reserved-keys",
+			Entry{Time: testTime, Level: slf4go_api.Debug, Message: "msg", Tags: slf4go_api.LogTags{"time": "t"}},
+			`{"time":"2024-01-02T15:04:05.123456789Z","level":"debug","msg":"msg","fields.time":"t"}` + "\n",
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+			assert.NoError(t, JSONEncoder{}.Encode(&buffer, scenario.entry))
+			if scenario.expected == "" {
+				assert.Regexp(t, `^\{"time":"[^"]+","level":"trace","msg":"msg","ch":"0x[0-9a-f]+","fn":"0x[0-9a-f]+"\}\n$`, buffer.String())
+				return
+			}
+			assert.Equal(t, scenario.expected, buffer.String())
+		})
+	}
+}
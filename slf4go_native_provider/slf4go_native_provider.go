@@ -0,0 +1,398 @@
+package slf4go_native_provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Slf4GoNativeLogger implements slf4go_api.Slf4GoLogger without any third-party logging library by
+// encoding entries directly onto an io.Writer. It serves as reference implementation of the facade semantics.
+type Slf4GoNativeLogger struct {
+	output            *output
+	encoder           Encoder
+	minLevel          slf4go_api.LogLevel
+	appComponent      slf4go_api.AppComponent
+	tags              slf4go_api.LogTags
+	componentTagLabel string
+	err               error
+	reportCaller      bool
+	stackTraceLevel   slf4go_api.LogLevel
+	componentLevels   *slf4go_api.ComponentLevels
+	now               func() time.Time
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(Slf4GoNativeLogger{}).PkgPath())
+}
+
+// Option configures a Slf4GoNativeLogger created by New.
+type Option func(logger *Slf4GoNativeLogger)
+
+// WithEncoder sets the encoder used to serialize entries. Defaults to TextEncoder.
+func WithEncoder(encoder Encoder) Option {
+	return func(logger *Slf4GoNativeLogger) {
+		logger.encoder = encoder
+	}
+}
+
+// WithMinLevel sets the least severe level that is still written. Defaults to slf4go_api.Info.
+func WithMinLevel(level slf4go_api.LogLevel) Option {
+	return func(logger *Slf4GoNativeLogger) {
+		logger.minLevel = level
+	}
+}
+
+// output serializes the writes of all loggers derived from the same New call.
+type output struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// New creates a new Slf4GoNativeLogger writing all entries to the given writer
+func New(writer io.Writer, options ...Option) *Slf4GoNativeLogger {
+	logger := &Slf4GoNativeLogger{
+		output:            &output{writer: writer},
+		encoder:           TextEncoder{},
+		minLevel:          slf4go_api.Info,
+		appComponent:      "",
+		tags:              make(slf4go_api.LogTags),
+		componentTagLabel: slf4go_api.DefaultAppComponentTag,
+		stackTraceLevel:   slf4go_api.NoStackTrace,
+		now:               time.Now,
+	}
+	for _, option := range options {
+		option(logger)
+	}
+	return logger
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers writing to the given writer,
+// to be installed via slf4go_api.RegisterProvider.
+func NewProvider(writer io.Writer, options ...Option) slf4go_api.Provider {
+	root := New(writer, options...)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+func (l *Slf4GoNativeLogger) derive() *Slf4GoNativeLogger {
+	derived := *l
+	return &derived
+}
+
+func (l *Slf4GoNativeLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	derived := l.derive()
+	derived.appComponent = component
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.componentTagLabel = componentTagLabel
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.tags = tags
+	return derived
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *Slf4GoNativeLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *Slf4GoNativeLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.err = err
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.reportCaller = enabled
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.stackTraceLevel = threshold
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	derived := l.derive()
+	derived.componentLevels = levels
+	return derived
+}
+
+func (l *Slf4GoNativeLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	return level <= slf4go_api.Trace && level <= l.minLevel && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *Slf4GoNativeLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *Slf4GoNativeLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *Slf4GoNativeLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.nativeLogWithTagsf(context.Background(), level, nil, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.nativeLogWithTagsf(context.Background(), level, tags, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.nativeLogWithTagsf(ctx, level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.nativeLogWithTagsf(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+// nativeLogWithTagsf writes the entry if its level is at least as severe as the minimum level. Fatal entries
+// terminate the program and Panic entries panic regardless of the minimum level.
+func (l *Slf4GoNativeLogger) nativeLogWithTagsf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Trace {
+		l.writeEntry(Entry{
+			Time:    l.now(),
+			Level:   slf4go_api.Error,
+			Message: fmt.Sprintf("Logging with unknown level '%s' failed. Not logging event", level.String()),
+			Tags:    slf4go_api.LogTags{},
+		})
+		return
+	}
+	enabled := l.IsEnabled(level)
+	if !enabled && level != slf4go_api.Fatal && level != slf4go_api.Panic {
+		return
+	}
+	msg := fmt.Sprintf(msgTemplate, slf4go_api.ResolveArgs(args)...)
+	if enabled {
+		l.write(level, tags, msg)
+	}
+	switch level {
+	case slf4go_api.Fatal:
+		slf4go_api.ExitCtx(ctx, msg)
+	case slf4go_api.Panic:
+		panic(msg)
+	}
+}
+
+func (l *Slf4GoNativeLogger) write(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msg string) {
+	if l.reportCaller {
+		tags = combineTags(slf4go_api.CallerTags(), tags)
+	}
+	if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+		tags = combineTags(slf4go_api.StackTraceTags(), tags)
+	}
+	tags = slf4go_api.ResolveTags(combineTags(l.tags, slf4go_api.ErrorTags(l.err), tags))
+	if len(l.appComponent) >= 1 {
+		tags[l.componentTagLabel] = l.appComponent
+	}
+	l.writeEntry(Entry{Time: l.now(), Level: level, Message: msg, Tags: tags})
+}
+
+func (l *Slf4GoNativeLogger) writeEntry(entry Entry) {
+	buffer := bufferPool.Get().(*bytes.Buffer)
+	buffer.Reset()
+	defer bufferPool.Put(buffer)
+
+	if err := l.encoder.Encode(buffer, entry); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Failed to encode log entry, %v\n", err)
+		return
+	}
+	l.output.mutex.Lock()
+	defer l.output.mutex.Unlock()
+	if _, err := l.output.writer.Write(buffer.Bytes()); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log, %v\n", err)
+	}
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *Slf4GoNativeLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoNativeLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
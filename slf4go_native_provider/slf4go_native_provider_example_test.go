@@ -0,0 +1,27 @@
+package slf4go_native_provider
+
+import (
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"os"
+)
+
+func ExampleNew() {
+	// logfmt output of Info entries and above
+	logger := New(os.Stdout)
+	logger.Infof("Server starting...")
+}
+
+func ExampleWithEncoder() {
+	// JSON output of Debug entries and above
+	logger := New(os.Stdout, WithEncoder(JSONEncoder{}), WithMinLevel(slf4go_api.Debug))
+	logger.Debugf("Server starting...")
+}
+
+func ExampleSlf4GoNativeLogger_WithStaticTags() {
+	logger := New(os.Stdout).WithStaticTags(slf4go_api.LogTags{
+		"requestID": "abc-123",
+		"userID":    "user-456",
+	})
+
+	logger.Infof("Processing request...")
+}
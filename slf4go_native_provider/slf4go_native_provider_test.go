@@ -0,0 +1,139 @@
+package slf4go_native_provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/MariusSchmidt/slf4go/internal/providertest"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestConformance(t *testing.T) {
+	providertest.Run(t, func(t *testing.T) (slf4go_api.Slf4GoLogger, providertest.Capture) {
+		setup := newTestingSetup()
+		return setup.slf4GoNativeLogger, jsonEntries(setup.output)
+	}, providertest.CapturedAs(providertest.JSONValues))
+}
+
+func TestLogging_UnknownLevel(t *testing.T) {
+	testConfig := newTestingSetup()
+	var unknownLevel slf4go_api.LogLevel = 666
+	testConfig.slf4GoNativeLogger.Logf(unknownLevel, "Some message not displayed")
+	assert.Equal(t, []providertest.Entry{{
+		Level:   slf4go_api.Error,
+		Message: "Logging with unknown level 'unknown' failed. Not logging event",
+		Tags:    slf4go_api.LogTags{},
+	}}, jsonEntries(testConfig.output)())
+}
+
+func TestLogging_MinLevel(t *testing.T) {
+	output := &bytes.Buffer{}
+	slf4GoNativeLogger := New(output, WithMinLevel(slf4go_api.Warn))
+	slf4GoNativeLogger.now = func() time.Time { return testTime }
+
+	slf4GoNativeLogger.Infof("not written")
+	slf4GoNativeLogger.Debugf("not written")
+	slf4GoNativeLogger.Tracef("not written")
+	assert.Empty(t, output.String())
+
+	slf4GoNativeLogger.Warnf("written")
+	err := slf4go_api.CatchFatal(slf4GoNativeLogger, func(logger slf4go_api.Slf4GoLogger) { logger.Fatalf("written") })
+	assert.Equal(t, &slf4go_api.FatalError{Message: "written", Code: slf4go_api.DefaultExitCode}, err)
+	assert.Equal(t, ""+
+		"time=2024-01-02T15:04:05.123456789Z level=warning msg=written\n"+
+		"time=2024-01-02T15:04:05.123456789Z level=fatal msg=written\n",
+		output.String())
+}
+
+func TestLogging_FatalAndPanicBelowMinLevel(t *testing.T) {
+	output := &bytes.Buffer{}
+	slf4GoNativeLogger := New(output, WithMinLevel(slf4go_api.Fatal))
+
+	assert.PanicsWithValue(t, "not written", func() {
+		slf4GoNativeLogger.Panicf("not written")
+	})
+	assert.Empty(t, output.String())
+
+	err := slf4go_api.CatchFatal(slf4GoNativeLogger, func(logger slf4go_api.Slf4GoLogger) {
+		logger.ForComponent("test-service").Fatalf("written")
+	})
+	assert.Equal(t, &slf4go_api.FatalError{Message: "written", Code: slf4go_api.DefaultExitCode}, err)
+	assert.Contains(t, output.String(), "level=fatal msg=written appComponent=test-service\n")
+}
+
+func TestLogging_ConcurrentWriters(t *testing.T) {
+	output := &bytes.Buffer{}
+	slf4GoNativeLogger := New(output, WithEncoder(JSONEncoder{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(logger slf4go_api.Slf4GoLogger) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				logger.InfoWithTagsf(slf4go_api.LogTags{"iteration": j}, "test message")
+			}
+		}(slf4GoNativeLogger.ForComponent(slf4go_api.AppComponent(fmt.Sprintf("component-%d", i))))
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Len(t, lines, 1000)
+	for _, line := range lines {
+		assert.True(t, json.Valid([]byte(line)), line)
+	}
+}
+
+func TestIsEnabled(t *testing.T) {
+	slf4GoNativeLogger := New(io.Discard, WithMinLevel(slf4go_api.Info))
+
+	scenarios := []struct {
+		name     string
+		level    slf4go_api.LogLevel
+		expected bool
+	}{
+		{"fatal", slf4go_api.Fatal, true},
+		{"panic", slf4go_api.Panic, true},
+		{"error", slf4go_api.Error, true},
+		{"warn", slf4go_api.Warn, true},
+		{"info", slf4go_api.Info, true},
+		{"debug", slf4go_api.Debug, false},
+		{"trace", slf4go_api.Trace, false},
+		{"unknown", 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4GoNativeLogger.IsEnabled(scenario.level))
+		})
+	}
+
+	t.Run("debug-and-trace", func(t *testing.T) {
+		assert.False(t, slf4GoNativeLogger.IsDebugEnabled())
+		assert.False(t, slf4GoNativeLogger.IsTraceEnabled())
+	})
+}
+
+type testingSetup struct {
+	slf4GoNativeLogger *Slf4GoNativeLogger
+	output             *bytes.Buffer
+}
+
+func newTestingSetup() *testingSetup {
+	output := &bytes.Buffer{}
+	slf4GoNativeLogger := New(output, WithEncoder(JSONEncoder{}), WithMinLevel(slf4go_api.Trace))
+	return &testingSetup{
+		slf4GoNativeLogger: slf4GoNativeLogger,
+		output:             output,
+	}
+}
+
+func jsonEntries(output *bytes.Buffer) providertest.Capture {
+	return providertest.JSONLines(output, LevelKey, MessageKey, TimeKey)
+}
@@ -0,0 +1,442 @@
+// Package slf4go_redact masks sensitive data in tags, messages and formatting arguments before any backend sees
+// them, e.g. passwords, access tokens, credit card numbers and email addresses.
+package slf4go_redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// maxDepth limits how deep nested maps, slices and structs are searched for sensitive data, which also stops
+// the search at cyclic data structures.
+const maxDepth = 10
+
+var (
+	// CreditCardPattern matches payment card numbers of 13 to 19 digits, optionally grouped by spaces or dashes.
+	// RedactCreditCards additionally checks the Luhn checksum, so that e.g. timestamps in milliseconds are kept.
+	CreditCardPattern = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+	// JWTPattern matches JSON Web Tokens, whose header always starts with eyJ when encoded.
+	JWTPattern = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	// EmailPattern matches email addresses.
+	EmailPattern = regexp.MustCompile(`\b[A-Za-z0-9._+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}\b`)
+)
+
+// Mask computes the masked form of a sensitive value.
+type Mask func(value string) string
+
+// Full replaces the whole value by slf4go_api.RedactedPlaceholder.
+func Full() Mask {
+	return Replace(slf4go_api.RedactedPlaceholder)
+}
+
+// Replace replaces the whole value by the given replacement.
+func Replace(replacement string) Mask {
+	return func(string) string {
+		return replacement
+	}
+}
+
+// Partial keeps the last visible characters of the value and replaces all others by '*', e.g.
+// ************1111 for a credit card number with visible 4. Values of at most twice the visible characters
+// are masked entirely, so that short secrets are not revealed for the most part.
+func Partial(visible int) Mask {
+	return func(value string) string {
+		length := utf8.RuneCountInString(value)
+		if visible <= 0 || length <= 2*visible {
+			return strings.Repeat("*", length)
+		}
+		runes := []rune(value)
+		return strings.Repeat("*", length-visible) + string(runes[length-visible:])
+	}
+}
+
+// Hash replaces the value by a prefix of its SHA-256 hash, e.g. sha256:5e884898da280471, which allows to
+// correlate entries by the value without revealing it. As hashes of guessable values such as passwords can be
+// reversed by trying all candidates, prefer Full for them.
+func Hash() Mask {
+	return func(value string) string {
+		sum := sha256.Sum256([]byte(value))
+		return "sha256:" + hex.EncodeToString(sum[:8])
+	}
+}
+
+// Option configures a Redactor.
+type Option func(r *Redactor)
+
+// RedactKeys masks the values of tags, map entries and struct fields whose key matches any of the given patterns.
+// Patterns are compared case-insensitively and may contain the wildcards of path.Match, e.g. "*token*".
+// Malformed patterns only match keys equal to them.
+func RedactKeys(mask Mask, patterns ...string) Option {
+	return func(r *Redactor) {
+		for _, pattern := range patterns {
+			r.keys = append(r.keys, keyRule{pattern: strings.ToLower(pattern), mask: mask})
+		}
+	}
+}
+
+// RedactValues masks all matches of the given regular expressions within messages, strings and the messages
+// of errors, regardless of their key.
+func RedactValues(mask Mask, patterns ...*regexp.Regexp) Option {
+	return func(r *Redactor) {
+		for _, pattern := range patterns {
+			r.values = append(r.values, valueRule{pattern: pattern, mask: mask})
+		}
+	}
+}
+
+// RedactCreditCards masks all matches of CreditCardPattern with a valid Luhn checksum.
+func RedactCreditCards(mask Mask) Option {
+	return func(r *Redactor) {
+		r.values = append(r.values, valueRule{pattern: CreditCardPattern, valid: luhnValid, mask: mask})
+	}
+}
+
+// RedactJWTs masks all matches of JWTPattern.
+func RedactJWTs(mask Mask) Option {
+	return RedactValues(mask, JWTPattern)
+}
+
+// RedactEmails masks all matches of EmailPattern.
+func RedactEmails(mask Mask) Option {
+	return RedactValues(mask, EmailPattern)
+}
+
+// WithSensitiveMask sets the mask of values wrapped via slf4go_api.Sensitive. Defaults to Full.
+func WithSensitiveMask(mask Mask) Option {
+	return func(r *Redactor) {
+		r.sensitive = mask
+	}
+}
+
+type keyRule struct {
+	pattern string
+	mask    Mask
+}
+
+func (rule keyRule) matches(key string) bool {
+	matched, err := path.Match(rule.pattern, key)
+	return matched || (err != nil && rule.pattern == key)
+}
+
+type valueRule struct {
+	pattern *regexp.Regexp
+	// valid, if set, filters the matches of pattern that are masked.
+	valid func(match string) bool
+	mask  Mask
+}
+
+// Redactor masks sensitive data by key, by content and if it is wrapped via slf4go_api.Sensitive. Nested maps,
+// slices and structs are searched as well: if any of their entries is masked, they are replaced by a copy in which
+// maps and structs are converted to map[string]interface{}, keyed by the json name of struct fields if present.
+// Unexported struct fields are left out of such copies. Value rules also apply to numbers, which are replaced by
+// their masked decimal notation if they match. Data without sensitive entries is left unchanged.
+//
+// A Redactor is safe for concurrent use once created.
+type Redactor struct {
+	keys      []keyRule
+	values    []valueRule
+	sensitive Mask
+}
+
+// NewRedactor creates a new Redactor applying the given rules.
+func NewRedactor(options ...Option) *Redactor {
+	r := &Redactor{sensitive: Full()}
+	for _, option := range options {
+		option(r)
+	}
+	return r
+}
+
+// RedactString masks all matches of the value rules within s.
+func (r *Redactor) RedactString(s string) string {
+	for _, rule := range r.values {
+		var masked strings.Builder
+		last := 0
+		for _, match := range rule.pattern.FindAllStringIndex(s, -1) {
+			value := s[match[0]:match[1]]
+			if rule.valid != nil && !rule.valid(value) {
+				continue
+			}
+			masked.WriteString(s[last:match[0]])
+			masked.WriteString(rule.mask(value))
+			last = match[1]
+		}
+		if last > 0 {
+			masked.WriteString(s[last:])
+			s = masked.String()
+		}
+	}
+	return s
+}
+
+// RedactValue masks the given value if it is stored under a sensitive key, wrapped via slf4go_api.Sensitive or
+// contains sensitive data. Formatting arguments have no key and are passed with an empty key.
+func (r *Redactor) RedactValue(key string, value interface{}) interface{} {
+	redacted, _ := r.redact(key, value, 0)
+	return redacted
+}
+
+// RedactTags masks the values of the given tags, which must not contain lazy values. If there is nothing to mask,
+// tags is returned as is, otherwise a new map is returned and tags is left unchanged.
+func (r *Redactor) RedactTags(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	var redactedTags slf4go_api.LogTags
+	for key, value := range tags {
+		redacted, changed := r.redact(key, value, 0)
+		if !changed {
+			continue
+		}
+		if redactedTags == nil {
+			redactedTags = make(slf4go_api.LogTags, len(tags))
+			for k, v := range tags {
+				redactedTags[k] = v
+			}
+		}
+		redactedTags[key] = redacted
+	}
+	if redactedTags == nil {
+		return tags
+	}
+	return redactedTags
+}
+
+// RedactArgs masks the given formatting arguments, which must not contain lazy values. Numbers are kept, as their
+// masked form breaks numeric verbs like %d, so the rendered message has to be masked via RedactString as well.
+// If there is nothing to mask, args is returned as is, otherwise a new slice is returned and args is left unchanged.
+func (r *Redactor) RedactArgs(args []interface{}) []interface{} {
+	var redactedArgs []interface{}
+	for i, arg := range args {
+		if _, isNumber := formatNumber(reflect.ValueOf(arg)); isNumber {
+			continue
+		}
+		redacted, changed := r.redact("", arg, 0)
+		if !changed {
+			continue
+		}
+		if redactedArgs == nil {
+			redactedArgs = make([]interface{}, len(args))
+			copy(redactedArgs, args)
+		}
+		redactedArgs[i] = redacted
+	}
+	if redactedArgs == nil {
+		return args
+	}
+	return redactedArgs
+}
+
+// RedactError returns an error whose message and the messages of all errors it wraps are masked, as written by
+// providers under slf4go_api.ErrorTag and slf4go_api.ErrorChainTag. The returned error still is and wraps the same
+// errors as err for errors.Is and errors.As. A nil error yields nil.
+func (r *Redactor) RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return redactedError{err: err, redactor: r}
+}
+
+// keyMask returns the mask of the first key rule matching key, or nil if there is none.
+func (r *Redactor) keyMask(key string) Mask {
+	if key == "" {
+		return nil
+	}
+	key = strings.ToLower(key)
+	for _, rule := range r.keys {
+		if rule.matches(key) {
+			return rule.mask
+		}
+	}
+	return nil
+}
+
+// redact masks value, stored under the given key, and reports whether anything has been masked.
+func (r *Redactor) redact(key string, value interface{}, depth int) (interface{}, bool) {
+	if sensitive, ok := value.(slf4go_api.SensitiveValue); ok {
+		return r.sensitive(fmt.Sprint(sensitive.Value())), true
+	}
+	if mask := r.keyMask(key); mask != nil {
+		return mask(fmt.Sprint(value)), true
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case string:
+		redacted := r.RedactString(v)
+		return redacted, redacted != v
+	case error:
+		if message := v.Error(); r.RedactString(message) != message {
+			return r.RedactError(v), true
+		}
+		return value, false
+	case fmt.Stringer:
+		s := v.String()
+		if redacted := r.RedactString(s); redacted != s {
+			return redacted, true
+		}
+		return value, false
+	}
+	if s, ok := formatNumber(reflect.ValueOf(value)); ok {
+		if redacted := r.RedactString(s); redacted != s {
+			return redacted, true
+		}
+		return value, false
+	}
+	if depth >= maxDepth {
+		return value, false
+	}
+	return r.redactNested(reflect.ValueOf(value), depth)
+}
+
+// redactNested searches maps, slices, arrays, structs and pointers to them for sensitive data.
+func (r *Redactor) redactNested(value reflect.Value, depth int) (interface{}, bool) {
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return value.Interface(), false
+		}
+		if redacted, changed := r.redact("", value.Elem().Interface(), depth+1); changed {
+			return redacted, true
+		}
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			break
+		}
+		redacted, changed := make(map[string]interface{}, value.Len()), false
+		for iter := value.MapRange(); iter.Next(); {
+			entry, entryChanged := r.redact(iter.Key().String(), iter.Value().Interface(), depth+1)
+			redacted[iter.Key().String()] = entry
+			changed = changed || entryChanged
+		}
+		if changed {
+			return redacted, true
+		}
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			break
+		}
+		redacted, changed := make([]interface{}, value.Len()), false
+		for i := range redacted {
+			var elementChanged bool
+			redacted[i], elementChanged = r.redact("", value.Index(i).Interface(), depth+1)
+			changed = changed || elementChanged
+		}
+		if changed {
+			return redacted, true
+		}
+	case reflect.Struct:
+		redacted, changed := make(map[string]interface{}, value.NumField()), false
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name := fieldName(field)
+			if name == "" {
+				continue
+			}
+			key := name
+			if r.keyMask(field.Name) != nil {
+				key = field.Name
+			}
+			entry, entryChanged := r.redact(key, value.Field(i).Interface(), depth+1)
+			redacted[name] = entry
+			changed = changed || entryChanged
+		}
+		if changed {
+			return redacted, true
+		}
+	}
+	return value.Interface(), false
+}
+
+// formatNumber formats integers and floats in decimal notation without exponent, so that value rules matching
+// digits, e.g. RedactCreditCards, apply to them as well. It reports false for values of other kinds.
+func formatNumber(value reflect.Value) (string, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, value.Type().Bits()), true
+	}
+	return "", false
+}
+
+// fieldName returns the name of the struct field in its json tag if present, or an empty name if the field is
+// excluded from JSON.
+func fieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// luhnValid reports whether the digits of number have a valid Luhn checksum, ignoring spaces and dashes.
+func luhnValid(number string) bool {
+	sum, double := 0, false
+	for i := len(number) - 1; i >= 0; i-- {
+		if number[i] < '0' || number[i] > '9' {
+			continue
+		}
+		digit := int(number[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// redactedError masks the messages of an error and of all errors it wraps, while errors.Is and errors.As still
+// see the original errors.
+type redactedError struct {
+	err      error
+	redactor *Redactor
+}
+
+func (e redactedError) Error() string {
+	return e.redactor.RedactString(e.err.Error())
+}
+
+func (e redactedError) Unwrap() []error {
+	var wrapped []error
+	switch wrapper := e.err.(type) {
+	case interface{ Unwrap() error }:
+		wrapped = []error{wrapper.Unwrap()}
+	case interface{ Unwrap() []error }:
+		wrapped = wrapper.Unwrap()
+	}
+	var redacted []error
+	for _, err := range wrapped {
+		if err != nil {
+			redacted = append(redacted, e.redactor.RedactError(err))
+		}
+	}
+	return redacted
+}
+
+func (e redactedError) Is(target error) bool {
+	return errors.Is(e.err, target)
+}
+
+func (e redactedError) As(target interface{}) bool {
+	return errors.As(e.err, target)
+}
@@ -0,0 +1,317 @@
+package slf4go_redact
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// RedactingLogger implements slf4go_api.Slf4GoLogger by masking sensitive data via a Redactor before passing
+// entries on to the target logger: tags, including static tags and tags attached to the context, formatting
+// arguments, the message and attached errors. Lazy arguments and tags are resolved after level filtering, so that
+// their values are masked as well. The message is rendered on the logging goroutine and passed on as the only
+// argument of a "%s" template, so that value patterns are also found where the template and the arguments meet.
+type RedactingLogger struct {
+	target   slf4go_api.Slf4GoLogger
+	redactor *Redactor
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(RedactingLogger{}).PkgPath())
+}
+
+// New creates a new RedactingLogger masking entries via redactor before writing them to target.
+func New(target slf4go_api.Slf4GoLogger, redactor *Redactor) *RedactingLogger {
+	return &RedactingLogger{target: target, redactor: redactor}
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers masking entries via redactor before writing them
+// to the root logger of the given provider, to be installed via slf4go_api.RegisterProvider.
+func NewProvider(provider slf4go_api.Provider, redactor *Redactor) slf4go_api.Provider {
+	root := New(provider.RootLogger(), redactor)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+// derive returns a logger masking entries via the same redactor before writing them to target.
+func (l *RedactingLogger) derive(target slf4go_api.Slf4GoLogger) *RedactingLogger {
+	return &RedactingLogger{target: target, redactor: l.redactor}
+}
+
+func (l *RedactingLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.ForComponent(component))
+}
+
+func (l *RedactingLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithAppComponentLabel(componentTagLabel))
+}
+
+// WithStaticTags masks the given tags once. Lazy values and lazy tags are masked whenever they are resolved.
+func (l *RedactingLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithStaticTags(l.redactStaticTags(tags)))
+}
+
+// AddStaticTags masks the given tags like WithStaticTags.
+func (l *RedactingLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(slf4go_api.AddStaticTags(l.target, l.redactStaticTags(tags)))
+}
+
+// redactStaticTags masks the given tags, wrapping lazy values and lazy tags so that they are masked once resolved.
+func (l *RedactingLogger) redactStaticTags(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	redacted := l.redactor.RedactTags(tags)
+	if !slf4go_api.ContainsLazy(redacted) {
+		return redacted
+	}
+	wrapped := make(slf4go_api.LogTags, len(redacted))
+	for key, value := range redacted {
+		switch v := value.(type) {
+		case slf4go_api.LazyValue:
+			wrapped[key] = slf4go_api.Lazy(func() interface{} {
+				return l.redactor.RedactTags(slf4go_api.LogTags{key: v()})[key]
+			})
+		case slf4go_api.LazyTagsValue:
+			wrapped[key] = slf4go_api.LazyTags(func() slf4go_api.LogTags {
+				return l.redactor.RedactTags(slf4go_api.ResolveTags(v()))
+			})
+		case slf4go_api.UnqualifiedLazyTagsValue:
+			wrapped[key] = slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags {
+				return l.redactor.RedactTags(slf4go_api.ResolveTags(v()))
+			})
+		default:
+			wrapped[key] = value
+		}
+	}
+	return wrapped
+}
+
+func (l *RedactingLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithError(l.redactor.RedactError(err)))
+}
+
+func (l *RedactingLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithCallerReporting(enabled))
+}
+
+func (l *RedactingLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithStackTrace(threshold))
+}
+
+func (l *RedactingLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	return l.derive(l.target.WithComponentLevels(levels))
+}
+
+func (l *RedactingLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	return l.target.IsEnabled(level)
+}
+
+func (l *RedactingLogger) IsDebugEnabled() bool {
+	return l.target.IsDebugEnabled()
+}
+
+func (l *RedactingLogger) IsTraceEnabled() bool {
+	return l.target.IsTraceEnabled()
+}
+
+func (l *RedactingLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.log(nil, level, nil, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.log(nil, level, tags, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.log(ctx, level, nil, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.log(ctx, level, tags, msgTemplate, args...)
+}
+
+// log masks the entry and writes it to the target logger. The arguments are masked before rendering the message,
+// so that sensitive keys within them are found, and the rendered message is masked afterwards. The tags attached to a non-nil ctx are masked along
+// with the dynamic tags, which take precedence over them as usual. As the target logger extracts the tags of ctx
+// itself, the masked tags are passed on as dynamic tags, overriding their unmasked values.
+func (l *RedactingLogger) log(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	terminates := level == slf4go_api.Fatal || level == slf4go_api.Panic
+	if !terminates && !l.target.IsEnabled(level) {
+		return
+	}
+	tags = slf4go_api.ResolveTags(tags)
+	if ctx != nil {
+		tags = combineTags(slf4go_api.ResolveTags(slf4go_api.ExtractContextTags(ctx)), tags)
+	}
+	tags = l.redactor.RedactTags(tags)
+	message := l.redactor.RedactString(fmt.Sprintf(msgTemplate, l.redactor.RedactArgs(slf4go_api.ResolveArgs(args))...))
+	if ctx == nil {
+		l.target.LogWithTagsf(level, tags, "%s", message)
+		return
+	}
+	l.target.LogWithTagsCtxf(ctx, level, tags, "%s", message)
+}
+
+// combineTags merges the given tag sets, later sets taking precedence over earlier ones.
+func combineTags(tagSets ...slf4go_api.LogTags) slf4go_api.LogTags {
+	combined := make(slf4go_api.LogTags)
+	for _, tags := range tagSets {
+		for key, value := range tags {
+			combined[key] = value
+		}
+	}
+	return combined
+}
+
+func (l *RedactingLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *RedactingLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
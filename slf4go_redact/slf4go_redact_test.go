@@ -0,0 +1,171 @@
+package slf4go_redact_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_redact"
+	"github.com/MariusSchmidt/slf4go/slf4go_test"
+)
+
+// The tests live in an external test package, as frames of the slf4go_redact package itself are skipped when
+// determining the call site.
+
+func newRedactor() *slf4go_redact.Redactor {
+	return slf4go_redact.NewRedactor(
+		slf4go_redact.RedactKeys(slf4go_redact.Full(), "password", "*token*", "authorization"),
+		slf4go_redact.RedactCreditCards(slf4go_redact.Partial(4)),
+		slf4go_redact.RedactEmails(slf4go_redact.Full()),
+	)
+}
+
+func TestRedactingLogger_Tags(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor()).
+		WithStaticTags(slf4go_api.LogTags{
+			"service":  "billing",
+			"apiToken": "t0k3n",
+			"lazy":     slf4go_api.UnqualifiedLazyTags(func() slf4go_api.LogTags { return slf4go_api.LogTags{"password": "s3cr3t"} }),
+		})
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"requestId": "r-1", "Authorization": "Bearer t0k3n"})
+
+	logger.InfoWithTagsCtxf(ctx, slf4go_api.LogTags{
+		"user":    "zaphod@heart-of-gold.example",
+		"orderId": slf4go_api.Lazy(func() interface{} { return 42 }),
+		"card":    slf4go_api.Lazy(func() interface{} { return "4111 1111 1111 1111" }),
+	}, "charging order")
+
+	slf4go_test.AssertLog(t, recorder).HasCount(1).Last().HasTags(slf4go_api.LogTags{
+		"service":       "billing",
+		"apiToken":      "[REDACTED]",
+		"password":      "[REDACTED]",
+		"requestId":     "r-1",
+		"Authorization": "[REDACTED]",
+		"user":          "[REDACTED]",
+		"orderId":       42,
+		"card":          "***************1111",
+	})
+}
+
+func TestRedactingLogger_AddStaticTags(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor()).
+		WithStaticTags(slf4go_api.LogTags{
+			"service": "billing",
+			"user":    slf4go_api.Lazy(func() interface{} { return "zaphod@heart-of-gold.example" }),
+		})
+
+	slf4go_api.AddStaticTags(logger, slf4go_api.LogTags{
+		"apiToken": "t0k3n",
+		"request":  slf4go_api.LazyTags(func() slf4go_api.LogTags { return slf4go_api.LogTags{"password": "s3cr3t"} }),
+	}).Infof("charging order")
+
+	slf4go_test.AssertLog(t, recorder).HasCount(1).Last().HasTags(slf4go_api.LogTags{
+		"service":          "billing",
+		"user":             "[REDACTED]",
+		"apiToken":         "[REDACTED]",
+		"request.password": "[REDACTED]",
+	})
+}
+
+func TestRedactingLogger_Message(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor())
+
+	logger.Infof("password reset for zaphod@heart-of-gold.example")
+	logger.Infof("charging card %s of %s with %s", "4111111111111111", slf4go_api.Lazy(func() interface{} { return "zaphod" }),
+		slf4go_api.Sensitive("cvc 123"))
+	logger.Infof("login %v", map[string]string{"user": "zaphod", "password": "s3cr3t"})
+	logger.Infof("charging card 4111 1111 %s", "1111 1111")
+	logger.Infof("charging card %d", 4111111111111111)
+
+	assert.Equal(t, []string{
+		"password reset for [REDACTED]",
+		"charging card ************1111 of zaphod with [REDACTED]",
+		"login map[password:[REDACTED] user:zaphod]",
+		"charging card ***************1111",
+		"charging card ************1111",
+	}, messages(recorder))
+	assert.Equal(t, "%s", recorder.Entries()[4].Template)
+}
+
+func TestRedactingLogger_Error(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor())
+	errUnknown := errors.New("unknown user zaphod@heart-of-gold.example")
+
+	logger.ErrorErrf(fmt.Errorf("login failed: %w", errUnknown), "login failed")
+
+	slf4go_test.AssertLog(t, recorder).HasCount(1).Last().
+		HasError(errUnknown).
+		HasTags(slf4go_api.LogTags{
+			slf4go_api.ErrorTag:      "login failed: unknown user [REDACTED]",
+			slf4go_api.ErrorChainTag: []string{"login failed: unknown user [REDACTED]", "unknown user [REDACTED]"},
+		})
+}
+
+func TestRedactingLogger_Levels(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor()).WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Info))
+	evaluated := false
+
+	assert.False(t, logger.IsDebugEnabled())
+	logger.Debugf("discarded %v", slf4go_api.Lazy(func() interface{} {
+		evaluated = true
+		return "s3cr3t"
+	}))
+	assert.PanicsWithValue(t, "card [REDACTED] declined", func() { logger.Panicf("card %s declined", slf4go_api.Sensitive("4111111111111111")) })
+	assert.PanicsWithValue(t, slf4go_test.ErrFatal, func() { logger.Fatalf("disk full") })
+
+	assert.False(t, evaluated)
+	assert.Equal(t, []string{"card [REDACTED] declined", "disk full"}, messages(recorder))
+}
+
+func TestRedactingLogger_CallerReporting(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_redact.New(recorder, newRedactor()).WithCallerReporting(true)
+
+	logger.Infof("test message")
+	line := currentLine() - 1
+
+	entries := recorder.Entries()
+	require.Len(t, entries, 1)
+	assert.True(t, strings.HasSuffix(fmt.Sprint(entries[0].Tags[slf4go_api.CallerTag]), fmt.Sprintf("/slf4go_redact_test.go:%d", line)),
+		"unexpected caller %v", entries[0].Tags[slf4go_api.CallerTag])
+}
+
+func TestNewProvider(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	provider := slf4go_redact.NewProvider(slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return recorder
+	}), newRedactor())
+
+	root := provider.RootLogger()
+	assert.Same(t, root, provider.RootLogger())
+	root.ForComponent("billing").InfoWithTagsf(slf4go_api.LogTags{"password": "s3cr3t"}, "charging order")
+
+	slf4go_test.AssertLog(t, recorder).HasCount(1).Last().
+		HasComponent("billing").
+		HasTags(slf4go_api.LogTags{"password": "[REDACTED]"})
+}
+
+func messages(recorder *slf4go_test.RecordingLogger) []string {
+	var messages []string
+	for _, entry := range recorder.Entries() {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
@@ -0,0 +1,115 @@
+package slf4go_slog_provider
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Slf4GoHandler is a slog.Handler that routes slog records into a slf4go_api.Slf4GoLogger.
+// Installing it via slog.SetDefault(slog.New(NewHandler(logger))) makes third-party libraries
+// logging through log/slog use the same slf4go configuration as the application.
+type Slf4GoHandler struct {
+	logger slf4go_api.Slf4GoLogger
+	tags   slf4go_api.LogTags
+	prefix string
+}
+
+// NewHandler creates a new Slf4GoHandler passing all records to the given logger.
+//
+// Attributes added via WithAttrs are kept by the handler and passed to the logger as tags of each record,
+// along with the attributes of the record, so that the static tags of the given logger are retained.
+func NewHandler(logger slf4go_api.Slf4GoLogger) *Slf4GoHandler {
+	return &Slf4GoHandler{
+		logger: logger,
+		tags:   make(slf4go_api.LogTags),
+		prefix: "",
+	}
+}
+
+// Enabled reports whether the wrapped logger emits entries at the slf4go_api.LogLevel the given level maps onto.
+func (h *Slf4GoHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return h.logger.IsEnabled(FromSlogLevel(level))
+}
+
+// Handle translates the record into a log entry of the wrapped logger. The attributes added via WithAttrs and
+// the attributes of the record become tags, attributes of groups are stored under dotted keys (e.g. "request.id").
+func (h *Slf4GoHandler) Handle(ctx context.Context, record slog.Record) error {
+	tags := make(slf4go_api.LogTags, len(h.tags)+record.NumAttrs())
+	for k, v := range h.tags {
+		tags[k] = v
+	}
+	record.Attrs(func(attr slog.Attr) bool {
+		addAttr(tags, h.prefix, attr)
+		return true
+	})
+	h.logger.LogWithTagsCtxf(ctx, FromSlogLevel(record.Level), tags, "%s", record.Message)
+	return nil
+}
+
+// WithAttrs returns a new Slf4GoHandler adding the given attributes to all records.
+func (h *Slf4GoHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+	tags := make(slf4go_api.LogTags, len(h.tags)+len(attrs))
+	for k, v := range h.tags {
+		tags[k] = v
+	}
+	for _, attr := range attrs {
+		addAttr(tags, h.prefix, attr)
+	}
+	return &Slf4GoHandler{
+		logger: h.logger,
+		tags:   tags,
+		prefix: h.prefix,
+	}
+}
+
+// WithGroup returns a new Slf4GoHandler that qualifies all subsequent attributes with the given group name.
+func (h *Slf4GoHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &Slf4GoHandler{
+		logger: h.logger,
+		tags:   h.tags,
+		prefix: h.prefix + name + ".",
+	}
+}
+
+// FromSlogLevel maps a slog level onto the next lower slf4go_api.LogLevel. Levels below slog.LevelDebug
+// map to slf4go_api.Trace. All levels from slog.LevelError upwards map to slf4go_api.Error, so that
+// records of third-party libraries never panic or terminate the program.
+func FromSlogLevel(level slog.Level) slf4go_api.LogLevel {
+	switch {
+	case level >= slog.LevelError:
+		return slf4go_api.Error
+	case level >= slog.LevelWarn:
+		return slf4go_api.Warn
+	case level >= slog.LevelInfo:
+		return slf4go_api.Info
+	case level >= slog.LevelDebug:
+		return slf4go_api.Debug
+	default:
+		return slf4go_api.Trace
+	}
+}
+
+func addAttr(tags slf4go_api.LogTags, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	if attr.Value.Kind() != slog.KindGroup {
+		tags[prefix+attr.Key] = attr.Value.Any()
+		return
+	}
+	if attr.Key != "" {
+		prefix = prefix + attr.Key + "."
+	}
+	for _, groupAttr := range attr.Value.Group() {
+		addAttr(tags, prefix, groupAttr)
+	}
+}
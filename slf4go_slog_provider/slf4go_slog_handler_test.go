@@ -0,0 +1,142 @@
+package slf4go_slog_provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_api/test_mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestHandler_Levels(t *testing.T) {
+	scenarios := []struct {
+		name      string
+		slogLevel slog.Level
+		logLevel  slf4go_api.LogLevel
+	}{
+		{"fatal", LevelFatal, slf4go_api.Error},
+		{"panic", LevelPanic, slf4go_api.Error},
+		{"error", slog.LevelError, slf4go_api.Error},
+		{"warn", slog.LevelWarn, slf4go_api.Warn},
+		{"info", slog.LevelInfo, slf4go_api.Info},
+		{"info+2", slog.LevelInfo + 2, slf4go_api.Info},
+		{"debug", slog.LevelDebug, slf4go_api.Debug},
+		{"trace", LevelTrace, slf4go_api.Trace},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+			mockLogger.EXPECT().IsEnabled(scenario.logLevel).Return(true)
+			mockLogger.EXPECT().
+				LogWithTagsCtxf(gomock.Any(), scenario.logLevel, slf4go_api.LogTags{}, "%s", "test message")
+
+			slog.New(NewHandler(mockLogger)).Log(context.Background(), scenario.slogLevel, "test message")
+		})
+	}
+}
+
+func TestHandler_Attributes(t *testing.T) {
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(gomock.Any()).Return(true)
+	mockLogger.EXPECT().
+		LogWithTagsCtxf(gomock.Any(), slf4go_api.Info, slf4go_api.LogTags{
+			"key1":           "val1",
+			"key2":           int64(42),
+			"request.id":     "abc-123",
+			"request.method": "GET",
+			"inlined":        true,
+			"duration":       time.Second,
+		}, "%s", "message with 100% verbs")
+
+	slog.New(NewHandler(mockLogger)).Info("message with 100% verbs",
+		"key1", "val1",
+		slog.Int("key2", 42),
+		slog.Group("request", slog.String("id", "abc-123"), slog.String("method", "GET")),
+		slog.Group("", slog.Bool("inlined", true)),
+		slog.Group("empty"),
+		slog.Duration("duration", time.Second),
+	)
+}
+
+func TestHandler_LogValuer(t *testing.T) {
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(gomock.Any()).Return(true)
+	mockLogger.EXPECT().
+		LogWithTagsCtxf(gomock.Any(), slf4go_api.Info, slf4go_api.LogTags{"user.name": "beeblebrox"}, "%s", "test message")
+
+	slog.New(NewHandler(mockLogger)).Info("test message", "user", testUser{"beeblebrox"})
+}
+
+func TestHandler_Context(t *testing.T) {
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"ctx_key1": "ctx_val1"})
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(gomock.Any()).Return(true)
+	mockLogger.EXPECT().
+		LogWithTagsCtxf(ctx, slf4go_api.Warn, slf4go_api.LogTags{}, "%s", "test message")
+
+	slog.New(NewHandler(mockLogger)).WarnContext(ctx, "test message")
+}
+
+func TestHandler_WithAttrs_WithGroup(t *testing.T) {
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(slf4go_api.Error).Return(true)
+	mockLogger.EXPECT().
+		LogWithTagsCtxf(gomock.Any(), slf4go_api.Error, slf4go_api.LogTags{
+			"service":           "billing",
+			"request.id":        "abc-123",
+			"request.user.name": "beeblebrox",
+		}, "%s", "test message")
+
+	slog.New(NewHandler(mockLogger)).
+		With("service", "billing").
+		WithGroup("").
+		WithGroup("request").
+		With("id", "abc-123").
+		With().
+		Error("test message", "user", testUser{"beeblebrox"})
+}
+
+func TestHandler_WithAttrs_RetainsStaticTags(t *testing.T) {
+	var buffer bytes.Buffer
+	logger := New(slog.New(slog.NewJSONHandler(&buffer, nil))).WithStaticTags(slf4go_api.LogTags{"service": "billing"})
+
+	slog.New(NewHandler(logger)).With("requestId", "r-1").Info("test message", "orderId", 42)
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &entry))
+	assert.Equal(t, "billing", entry["service"])
+	assert.Equal(t, "r-1", entry["requestId"])
+	assert.Equal(t, float64(42), entry["orderId"])
+}
+
+func TestHandler_Enabled(t *testing.T) {
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(slf4go_api.Info).Return(true)
+	mockLogger.EXPECT().IsEnabled(slf4go_api.Debug).Return(false)
+	handler := NewHandler(mockLogger)
+
+	assert.True(t, handler.Enabled(context.Background(), slog.LevelInfo))
+	assert.False(t, handler.Enabled(context.Background(), slog.LevelDebug))
+}
+
+func TestHandler_Disabled(t *testing.T) {
+	mockLogger := test_mocks.NewMockSlf4GoLogger(gomock.NewController(t))
+	mockLogger.EXPECT().IsEnabled(slf4go_api.Debug).Return(false)
+
+	slog.New(NewHandler(mockLogger)).Debug("test message", "key1", "val1")
+}
+
+type testUser struct {
+	name string
+}
+
+func (u testUser) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("name", u.name))
+}
@@ -0,0 +1,444 @@
+package slf4go_slog_provider
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Additional slog levels for the slf4go log levels that have no counterpart in log/slog.
+const (
+	// LevelTrace is used for slf4go_api.Trace entries and ranks below slog.LevelDebug.
+	LevelTrace = slog.LevelDebug - 4
+	// LevelPanic is used for slf4go_api.Panic entries and ranks above slog.LevelError.
+	LevelPanic = slog.LevelError + 4
+	// LevelFatal is used for slf4go_api.Fatal entries and ranks above LevelPanic.
+	LevelFatal = slog.LevelError + 8
+)
+
+type Slf4GoSlogLogger struct {
+	logger            *slog.Logger
+	appComponent      slf4go_api.AppComponent
+	tags              slf4go_api.LogTags
+	componentTagLabel string
+	err               error
+	reportCaller      bool
+	stackTraceLevel   slf4go_api.LogLevel
+	componentLevels   *slf4go_api.ComponentLevels
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(Slf4GoSlogLogger{}).PkgPath())
+	// records bridged via Slf4GoHandler report the caller of the slog.Logger
+	slf4go_api.RegisterLoggingPackage("log/slog")
+}
+
+// New creates a new Slf4GoSlogLogger writing all entries to the given slog.Logger
+func New(slogLogger *slog.Logger) *Slf4GoSlogLogger {
+	return &Slf4GoSlogLogger{
+		logger:            slogLogger,
+		appComponent:      "",
+		tags:              make(slf4go_api.LogTags),
+		componentTagLabel: slf4go_api.DefaultAppComponentTag,
+		stackTraceLevel:   slf4go_api.NoStackTrace,
+	}
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers writing to the given slog.Logger,
+// to be installed via slf4go_api.RegisterProvider.
+func NewProvider(slogLogger *slog.Logger) slf4go_api.Provider {
+	root := New(slogLogger)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+// ReplaceLevelAttr can be used as slog.HandlerOptions.ReplaceAttr to render the additional levels
+// LevelTrace, LevelPanic and LevelFatal as TRACE, PANIC and FATAL instead of DEBUG-4, ERROR+4 and ERROR+8.
+func ReplaceLevelAttr(groups []string, attr slog.Attr) slog.Attr {
+	if len(groups) > 0 || attr.Key != slog.LevelKey {
+		return attr
+	}
+	level, ok := attr.Value.Any().(slog.Level)
+	if !ok {
+		return attr
+	}
+	switch level {
+	case LevelTrace:
+		attr.Value = slog.StringValue("TRACE")
+	case LevelPanic:
+		attr.Value = slog.StringValue("PANIC")
+	case LevelFatal:
+		attr.Value = slog.StringValue("FATAL")
+	}
+	return attr
+}
+
+func (l *Slf4GoSlogLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      component,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *Slf4GoSlogLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *Slf4GoSlogLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      enabled,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   threshold,
+		componentLevels:   l.componentLevels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	return &Slf4GoSlogLogger{
+		logger:            l.logger,
+		appComponent:      l.appComponent,
+		tags:              l.tags,
+		componentTagLabel: l.componentTagLabel,
+		err:               l.err,
+		reportCaller:      l.reportCaller,
+		stackTraceLevel:   l.stackTraceLevel,
+		componentLevels:   levels,
+	}
+}
+
+func (l *Slf4GoSlogLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	slogLevel, ok := toSlogLevel(level)
+	return ok && l.logger.Enabled(context.Background(), slogLevel) && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *Slf4GoSlogLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *Slf4GoSlogLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *Slf4GoSlogLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(level, slf4go_api.LogTags{}, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.slogLogWithTagsf(context.Background(), level, tags, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, level, slf4go_api.LogTags{}, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if slogLevel, ok := toSlogLevel(level); ok && level > slf4go_api.Panic &&
+		!(l.logger.Enabled(ctx, slogLevel) && l.componentLevels.IsEnabled(l.appComponent, level)) {
+		return
+	}
+	l.slogLogWithTagsf(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) slogLogWithTagsf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	slogLevel, ok := toSlogLevel(level)
+	if !ok {
+		l.logger.ErrorContext(ctx, fmt.Sprintf("Mapping error level '%s' onto slog level failed. Not logging event", level.String()))
+		return
+	}
+	enabled := l.logger.Enabled(ctx, slogLevel) && l.componentLevels.IsEnabled(l.appComponent, level)
+	if !enabled && level != slf4go_api.Fatal && level != slf4go_api.Panic {
+		return
+	}
+	msg := fmt.Sprintf(msgTemplate, slf4go_api.ResolveArgs(args)...)
+	if enabled {
+		if l.reportCaller {
+			tags = combineTags(slf4go_api.CallerTags(), tags)
+		}
+		if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+			tags = combineTags(slf4go_api.StackTraceTags(), tags)
+		}
+		l.logger.LogAttrs(ctx, slogLevel, msg, l.attrs(tags)...)
+	}
+	switch level {
+	case slf4go_api.Fatal:
+		slf4go_api.ExitCtx(ctx, msg)
+	case slf4go_api.Panic:
+		panic(msg)
+	}
+}
+
+// attrs converts the component, static tags, attached error and dynamic tags into slog attributes. The component
+// attribute comes first, followed by all tags sorted by key, so that the attribute order is stable across entries.
+func (l *Slf4GoSlogLogger) attrs(tags slf4go_api.LogTags) []slog.Attr {
+	tags = slf4go_api.ResolveTags(combineTags(l.tags, slf4go_api.ErrorTags(l.err), tags))
+	attrs := make([]slog.Attr, 0, len(tags)+1)
+	if len(l.appComponent) >= 1 {
+		delete(tags, l.componentTagLabel)
+		attrs = append(attrs, slog.String(l.componentTagLabel, string(l.appComponent)))
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, slog.Any(k, tags[k]))
+	}
+	return attrs
+}
+
+func toSlogLevel(level slf4go_api.LogLevel) (slog.Level, bool) {
+	switch level {
+	case slf4go_api.Fatal:
+		return LevelFatal, true
+	case slf4go_api.Panic:
+		return LevelPanic, true
+	case slf4go_api.Error:
+		return slog.LevelError, true
+	case slf4go_api.Warn:
+		return slog.LevelWarn, true
+	case slf4go_api.Info:
+		return slog.LevelInfo, true
+	case slf4go_api.Debug:
+		return slog.LevelDebug, true
+	case slf4go_api.Trace:
+		return LevelTrace, true
+	default:
+		return 0, false
+	}
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *Slf4GoSlogLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoSlogLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
@@ -0,0 +1,67 @@
+package slf4go_slog_provider_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_slog_provider"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// The caller tests live in an external test package, as frames of the provider package itself are skipped
+// when determining the call site.
+
+func TestCallerReporting_Handler(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slf4go_slog_provider.New(slog.New(slog.NewJSONHandler(output, nil))).WithCallerReporting(true)
+
+	slog.New(slf4go_slog_provider.NewHandler(logger)).Info("test message")
+	assertCaller(t, output, currentLine()-1, "TestCallerReporting_Handler")
+}
+
+func TestStackTrace(t *testing.T) {
+	output := &bytes.Buffer{}
+	logger := slf4go_slog_provider.New(slog.New(slog.NewJSONHandler(output, nil))).WithStackTrace(slf4go_api.Error)
+
+	t.Run("error", func(t *testing.T) {
+		output.Reset()
+		logger.Errorf("test message")
+		entry := lastEntry(t, output)
+		stackTrace, ok := entry[slf4go_api.StackTraceTag].([]interface{})
+		if assert.True(t, ok) && assert.NotEmpty(t, stackTrace) {
+			assert.True(t, strings.HasPrefix(fmt.Sprint(stackTrace[0].(map[string]interface{})["function"]), "github.com/MariusSchmidt/slf4go/slf4go_slog_provider_test.TestStackTrace"),
+				"unexpected frame %v", stackTrace[0])
+		}
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		output.Reset()
+		logger.Warnf("test message")
+		assert.NotContains(t, lastEntry(t, output), slf4go_api.StackTraceTag)
+	})
+}
+
+func assertCaller(t *testing.T, output *bytes.Buffer, line int, testName string) {
+	entry := lastEntry(t, output)
+	assert.True(t, strings.HasSuffix(fmt.Sprint(entry[slf4go_api.CallerTag]), fmt.Sprintf("/slf4go_slog_provider_caller_test.go:%d", line)),
+		"unexpected caller %v", entry[slf4go_api.CallerTag])
+	assert.True(t, strings.HasPrefix(fmt.Sprint(entry[slf4go_api.FunctionTag]), "github.com/MariusSchmidt/slf4go/slf4go_slog_provider_test."+testName),
+		"unexpected function %v", entry[slf4go_api.FunctionTag])
+}
+
+func lastEntry(t *testing.T, output *bytes.Buffer) map[string]interface{} {
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	entry := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &entry))
+	return entry
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
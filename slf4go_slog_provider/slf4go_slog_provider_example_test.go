@@ -0,0 +1,43 @@
+package slf4go_slog_provider
+
+import (
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"log/slog"
+	"os"
+)
+
+func ExampleNew() {
+	// default instantiation
+	logger := New(slog.Default())
+	logger.Infof("Server starting...")
+}
+
+func ExampleReplaceLevelAttr() {
+	// render Trace, Panic and Fatal entries with readable level names
+	logger := New(slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level:       LevelTrace,
+		ReplaceAttr: ReplaceLevelAttr,
+	})))
+	logger.Tracef("Server starting...")
+}
+
+func ExampleSlf4GoSlogLogger_ForComponent() {
+	logger := New(slog.Default()).ForComponent("service")
+	logger.Infof("Server starting...")
+}
+
+func ExampleSlf4GoSlogLogger_WithStaticTags() {
+	logger := New(slog.Default()).WithStaticTags(slf4go_api.LogTags{
+		"requestID": "abc-123",
+		"userID":    "user-456",
+	})
+
+	logger.Infof("Processing request...")
+}
+
+func ExampleNewHandler() {
+	// route all records of libraries logging via log/slog into an Slf4GoLogger
+	var logger slf4go_api.Slf4GoLogger = New(slog.New(slog.NewJSONHandler(os.Stdout, nil)))
+	slog.SetDefault(slog.New(NewHandler(logger)))
+	slog.Info("Server starting...", "port", 8080)
+}
@@ -0,0 +1,221 @@
+package slf4go_slog_provider
+
+import (
+	"bytes"
+	"context"
+	"github.com/MariusSchmidt/slf4go/internal/providertest"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	providertest.Run(t, func(t *testing.T) (slf4go_api.Slf4GoLogger, providertest.Capture) {
+		setup := newTestingSetup()
+		return setup.slf4GoSlogLogger, setup.handler.entries
+	}, providertest.CapturedAs(capturedBySlog))
+}
+
+// capturedBySlog converts tags into the values of the slog attributes the provider writes: components are written
+// as strings and slog.AnyValue stores all signed integers as int64.
+func capturedBySlog(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	converted := make(slf4go_api.LogTags, len(tags))
+	for key, value := range providertest.ComponentsAsStrings(tags) {
+		converted[key] = slog.AnyValue(value).Any()
+	}
+	return converted
+}
+
+func TestLogging_UnknownLevel(t *testing.T) {
+	testConfig := newTestingSetup()
+	var unknownLevel slf4go_api.LogLevel = 666
+	testConfig.slf4GoSlogLogger.Logf(unknownLevel, "Some message not displayed")
+	assert.Equal(t, []providertest.Entry{{
+		Level:   slf4go_api.Error,
+		Message: "Mapping error level 'unknown' onto slog level failed. Not logging event",
+		Tags:    slf4go_api.LogTags{},
+	}}, testConfig.handler.entries())
+}
+
+func TestLogging_AttributeOrder(t *testing.T) {
+	output := &bytes.Buffer{}
+	handler := slog.NewTextHandler(output, &slog.HandlerOptions{ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+		if attr.Key == slog.TimeKey && len(groups) == 0 {
+			return slog.Attr{}
+		}
+		return attr
+	}})
+	logger := New(slog.New(handler)).
+		ForComponent("test-service").
+		WithStaticTags(map[string]interface{}{"b_key": "b_val", "d_key": "d_val"})
+
+	logger.InfoWithTagsf(map[string]interface{}{"c_key": "c_val", "a_key": "a_val"}, "test message")
+
+	assert.Equal(t, `level=INFO msg="test message" appComponent=test-service a_key=a_val b_key=b_val c_key=c_val d_key=d_val`+"\n", output.String())
+}
+
+func TestLogging_DisabledLevel(t *testing.T) {
+	handler := newRecordingHandler()
+	slf4GoSlogLogger := New(slog.New(levelFilter{handler, slog.LevelInfo}))
+
+	slf4GoSlogLogger.Debugf("test message")
+	slf4GoSlogLogger.Tracef("test message")
+	assert.Empty(t, handler.records())
+
+	err := slf4go_api.CatchFatal(slf4GoSlogLogger, func(logger slf4go_api.Slf4GoLogger) { logger.Fatalf("test message") })
+	assert.Equal(t, &slf4go_api.FatalError{Message: "test message", Code: slf4go_api.DefaultExitCode}, err)
+	require.Len(t, handler.records(), 1)
+	assert.Equal(t, LevelFatal, handler.records()[0].Level)
+	assert.Equal(t, "test message", handler.records()[0].Message)
+}
+
+func TestReplaceLevelAttr(t *testing.T) {
+	scenarios := []struct {
+		level    slog.Level
+		expected string
+	}{
+		{LevelFatal, "FATAL"},
+		{LevelPanic, "PANIC"},
+		{slog.LevelError, "ERROR"},
+		{slog.LevelWarn, "WARN"},
+		{slog.LevelInfo, "INFO"},
+		{slog.LevelDebug, "DEBUG"},
+		{LevelTrace, "TRACE"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.expected, func(t *testing.T) {
+			var buffer bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{
+				Level:       LevelTrace,
+				ReplaceAttr: ReplaceLevelAttr,
+			}))
+			logger.Log(context.Background(), scenario.level, "test message")
+			assert.Contains(t, buffer.String(), "level="+scenario.expected+" ")
+		})
+	}
+}
+
+func TestIsEnabled(t *testing.T) {
+	slf4GoSlogLogger := New(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	scenarios := []struct {
+		name     string
+		level    slf4go_api.LogLevel
+		expected bool
+	}{
+		{"fatal", slf4go_api.Fatal, true},
+		{"panic", slf4go_api.Panic, true},
+		{"error", slf4go_api.Error, true},
+		{"warn", slf4go_api.Warn, true},
+		{"info", slf4go_api.Info, true},
+		{"debug", slf4go_api.Debug, false},
+		{"trace", slf4go_api.Trace, false},
+		{"unknown", 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4GoSlogLogger.IsEnabled(scenario.level))
+		})
+	}
+
+	t.Run("debug-and-trace", func(t *testing.T) {
+		assert.False(t, slf4GoSlogLogger.IsDebugEnabled())
+		assert.False(t, slf4GoSlogLogger.IsTraceEnabled())
+	})
+}
+
+type testingSetup struct {
+	slf4GoSlogLogger *Slf4GoSlogLogger
+	handler          *recordingHandler
+}
+
+func newTestingSetup() *testingSetup {
+	handler := newRecordingHandler()
+	slf4GoSlogLogger := New(slog.New(handler))
+	return &testingSetup{
+		slf4GoSlogLogger: slf4GoSlogLogger,
+		handler:          handler,
+	}
+}
+
+// levelFilter drops all records below the given minimum level before passing them to the wrapped handler.
+type levelFilter struct {
+	slog.Handler
+	minLevel slog.Level
+}
+
+func (f levelFilter) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= f.minLevel
+}
+
+// recordingHandler is a slog.Handler that keeps all handled records in memory. Handlers derived via WithAttrs
+// record into the same records, adding their attributes. Groups are not supported.
+type recordingHandler struct {
+	handled *[]slog.Record
+	attrs   []slog.Attr
+}
+
+func newRecordingHandler() *recordingHandler {
+	return &recordingHandler{handled: &[]slog.Record{}}
+}
+
+func (h *recordingHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *recordingHandler) Handle(_ context.Context, record slog.Record) error {
+	record = record.Clone()
+	record.AddAttrs(h.attrs...)
+	*h.handled = append(*h.handled, record)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &recordingHandler{handled: h.handled, attrs: append(append([]slog.Attr(nil), h.attrs...), attrs...)}
+}
+
+func (h *recordingHandler) WithGroup(string) slog.Handler {
+	return h
+}
+
+func (h *recordingHandler) records() []slog.Record {
+	return *h.handled
+}
+
+// entries converts the handled records into entries, mapping the slog levels back onto slf4go_api levels.
+func (h *recordingHandler) entries() []providertest.Entry {
+	var entries []providertest.Entry
+	for _, record := range h.records() {
+		tags := make(slf4go_api.LogTags)
+		record.Attrs(func(attr slog.Attr) bool {
+			tags[attr.Key] = attr.Value.Any()
+			return true
+		})
+		entries = append(entries, providertest.Entry{Level: fromSlogLevel(record.Level), Message: record.Message, Tags: tags})
+	}
+	return entries
+}
+
+func fromSlogLevel(level slog.Level) slf4go_api.LogLevel {
+	switch {
+	case level >= LevelFatal:
+		return slf4go_api.Fatal
+	case level >= LevelPanic:
+		return slf4go_api.Panic
+	case level >= slog.LevelError:
+		return slf4go_api.Error
+	case level >= slog.LevelWarn:
+		return slf4go_api.Warn
+	case level >= slog.LevelInfo:
+		return slf4go_api.Info
+	case level >= slog.LevelDebug:
+		return slf4go_api.Debug
+	default:
+		return slf4go_api.Trace
+	}
+}
@@ -0,0 +1,405 @@
+// Package slf4go_tee dispatches each entry to several child loggers, e.g. to write human-readable entries to the
+// console, JSON entries to a file and errors to an alerting sink.
+package slf4go_tee
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// Option configures a TeeLogger.
+type Option func(logger *TeeLogger)
+
+// SinkOption configures a sink added via WithSink.
+type SinkOption func(sink *sink)
+
+// WithSink adds a child logger entries are dispatched to. Without sink options, the sink receives all entries
+// enabled by the child logger itself.
+func WithSink(logger slf4go_api.Slf4GoLogger, options ...SinkOption) Option {
+	return func(tee *TeeLogger) {
+		s := sink{logger: logger, minLevel: slf4go_api.Trace}
+		for _, option := range options {
+			option(&s)
+		}
+		tee.sinks = append(tee.sinks, s)
+	}
+}
+
+// WithErrorHandler sets the function called if a child logger panics while writing an entry. The other sinks
+// still receive the entry. By default, the panic is reported on stderr.
+func WithErrorHandler(onError func(err error)) Option {
+	return func(tee *TeeLogger) {
+		tee.onError = onError
+	}
+}
+
+// MinLevel restricts the sink to entries at least as severe as the given level.
+func MinLevel(level slf4go_api.LogLevel) SinkOption {
+	return func(sink *sink) {
+		sink.minLevel = level
+	}
+}
+
+// OnlyComponents restricts the sink to entries of the given components and their descendants, e.g. "billing"
+// also matches "billing.invoice".
+func OnlyComponents(components ...slf4go_api.AppComponent) SinkOption {
+	return func(sink *sink) {
+		sink.only = append(sink.only, components...)
+	}
+}
+
+// ExceptComponents excludes entries of the given components and their descendants from the sink.
+func ExceptComponents(components ...slf4go_api.AppComponent) SinkOption {
+	return func(sink *sink) {
+		sink.except = append(sink.except, components...)
+	}
+}
+
+// sink is a child logger along with the filters applied before dispatching entries to it.
+type sink struct {
+	logger   slf4go_api.Slf4GoLogger
+	minLevel slf4go_api.LogLevel
+	only     []slf4go_api.AppComponent
+	except   []slf4go_api.AppComponent
+}
+
+// accepts reports whether entries of the given level and component pass the filters of the sink.
+func (s sink) accepts(level slf4go_api.LogLevel, component slf4go_api.AppComponent) bool {
+	if level > s.minLevel {
+		return false
+	}
+	if len(s.only) > 0 && !matchesAny(component, s.only) {
+		return false
+	}
+	return !matchesAny(component, s.except)
+}
+
+func matchesAny(component slf4go_api.AppComponent, components []slf4go_api.AppComponent) bool {
+	for _, c := range components {
+		if component == c || strings.HasPrefix(string(component), string(c)+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// TeeLogger implements slf4go_api.Slf4GoLogger by dispatching each entry to the child loggers whose sink filters
+// it passes. Derived loggers derive all child loggers, e.g. ForComponent and WithStaticTags apply to every sink.
+// Lazy arguments and tags are resolved once for all sinks.
+//
+// A child logger panicking while writing an entry is reported via the error handler and does not keep the entry
+// from the other sinks. Panic and Fatal entries are written to all accepting sinks before the TeeLogger panics
+// or terminates the program via slf4go_api.Exit once, regardless of the sink filters.
+type TeeLogger struct {
+	sinks     []sink
+	component slf4go_api.AppComponent
+	onError   func(err error)
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(TeeLogger{}).PkgPath())
+}
+
+// New creates a new TeeLogger dispatching to the sinks added via WithSink.
+func New(options ...Option) *TeeLogger {
+	l := &TeeLogger{
+		onError: func(err error) {
+			_, _ = fmt.Fprintf(os.Stderr, "Failed to write to log sink, %v\n", err)
+		},
+	}
+	for _, option := range options {
+		option(l)
+	}
+	return l
+}
+
+// derive returns a copy of the logger with each child logger replaced by the result of derive.
+func (l *TeeLogger) derive(derive func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger) *TeeLogger {
+	derived := *l
+	derived.sinks = make([]sink, len(l.sinks))
+	for i, s := range l.sinks {
+		s.logger = derive(s.logger)
+		derived.sinks[i] = s
+	}
+	return &derived
+}
+
+func (l *TeeLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	derived := l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.ForComponent(component)
+	})
+	derived.component = component
+	return derived
+}
+
+func (l *TeeLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithAppComponentLabel(componentTagLabel)
+	})
+}
+
+func (l *TeeLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithStaticTags(tags)
+	})
+}
+
+func (l *TeeLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return slf4go_api.AddStaticTags(logger, tags)
+	})
+}
+
+func (l *TeeLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithError(err)
+	})
+}
+
+func (l *TeeLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithCallerReporting(enabled)
+	})
+}
+
+func (l *TeeLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithStackTrace(threshold)
+	})
+}
+
+func (l *TeeLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	return l.derive(func(logger slf4go_api.Slf4GoLogger) slf4go_api.Slf4GoLogger {
+		return logger.WithComponentLevels(levels)
+	})
+}
+
+// IsEnabled reports whether any sink accepts entries of the given level.
+func (l *TeeLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	for _, s := range l.sinks {
+		if s.accepts(level, l.component) && s.logger.IsEnabled(level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *TeeLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *TeeLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *TeeLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.dispatch(context.Background(), level, nil, msgTemplate, args...)
+}
+
+func (l *TeeLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.dispatch(context.Background(), level, tags, msgTemplate, args...)
+}
+
+func (l *TeeLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *TeeLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.dispatch(ctx, level, nil, msgTemplate, args...)
+}
+
+func (l *TeeLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.dispatch(ctx, level, tags, msgTemplate, args...)
+}
+
+// dispatch writes the entry to every accepting sink. Panic and Fatal entries panic or terminate the program once
+// all sinks have been written to, the children's own panics and program exits are caught.
+func (l *TeeLogger) dispatch(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	terminates := level == slf4go_api.Fatal || level == slf4go_api.Panic
+	if !terminates && !l.IsEnabled(level) {
+		return
+	}
+	tags = slf4go_api.ResolveTags(tags)
+	args = slf4go_api.ResolveArgs(args)
+	for _, s := range l.sinks {
+		if s.accepts(level, l.component) {
+			l.write(ctx, s, level, tags, msgTemplate, args)
+		}
+	}
+	switch level {
+	case slf4go_api.Fatal:
+		slf4go_api.ExitCtx(ctx, fmt.Sprintf(msgTemplate, args...))
+	case slf4go_api.Panic:
+		panic(fmt.Sprintf(msgTemplate, args...))
+	}
+}
+
+// write writes the entry to a single sink, recovering from its panics. Panics and program exits caused by Panic
+// and Fatal entries are expected and not reported.
+func (l *TeeLogger) write(ctx context.Context, s sink, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args []interface{}) {
+	defer func() {
+		if recovered := recover(); recovered != nil && level != slf4go_api.Panic && level != slf4go_api.Fatal {
+			l.onError(fmt.Errorf("sink %T panicked: %v", s.logger, recovered))
+		}
+	}()
+	if level == slf4go_api.Fatal {
+		_ = slf4go_api.CatchFatal(s.logger, func(logger slf4go_api.Slf4GoLogger) {
+			logger.LogWithTagsCtxf(ctx, level, tags, msgTemplate, args...)
+		})
+		return
+	}
+	s.logger.LogWithTagsCtxf(ctx, level, tags, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *TeeLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *TeeLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *TeeLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *TeeLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *TeeLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *TeeLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *TeeLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *TeeLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *TeeLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *TeeLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *TeeLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *TeeLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *TeeLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
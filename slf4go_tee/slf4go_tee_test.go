@@ -0,0 +1,237 @@
+package slf4go_tee_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_native_provider"
+	"github.com/MariusSchmidt/slf4go/slf4go_tee"
+	"github.com/MariusSchmidt/slf4go/slf4go_test"
+)
+
+// The tests live in an external test package, as frames of the slf4go_tee package itself are skipped when
+// determining the call site.
+
+// failingLogger panics on every entry, like a sink whose output broke.
+type failingLogger struct {
+	slf4go_api.Slf4GoLogger
+}
+
+func (failingLogger) LogWithTagsCtxf(context.Context, slf4go_api.LogLevel, slf4go_api.LogTags, string, ...interface{}) {
+	panic("connection refused")
+}
+
+func messages(recorder *slf4go_test.RecordingLogger) []string {
+	var messages []string
+	for _, entry := range recorder.Entries() {
+		messages = append(messages, entry.Message)
+	}
+	return messages
+}
+
+func TestTeeLogger_MinLevels(t *testing.T) {
+	console, file, alerting := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(console, slf4go_tee.MinLevel(slf4go_api.Info)),
+		slf4go_tee.WithSink(file, slf4go_tee.MinLevel(slf4go_api.Debug)),
+		slf4go_tee.WithSink(alerting, slf4go_tee.MinLevel(slf4go_api.Error)))
+
+	logger.Tracef("trace")
+	logger.Debugf("debug")
+	logger.Infof("info")
+	logger.Errorf("error")
+
+	assert.Equal(t, []string{"info", "error"}, messages(console))
+	assert.Equal(t, []string{"debug", "info", "error"}, messages(file))
+	assert.Equal(t, []string{"error"}, messages(alerting))
+}
+
+func TestTeeLogger_ComponentFilters(t *testing.T) {
+	billing, others := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(billing, slf4go_tee.OnlyComponents("billing")),
+		slf4go_tee.WithSink(others, slf4go_tee.ExceptComponents("billing", "shipping")))
+
+	logger.Infof("root")
+	logger.ForComponent("billing").Infof("billing")
+	logger.ForComponent("billing.invoice").Infof("billing.invoice")
+	logger.ForComponent("billingreport").Infof("billingreport")
+	logger.ForComponent("shipping").Infof("shipping")
+
+	assert.Equal(t, []string{"billing", "billing.invoice"}, messages(billing))
+	assert.Equal(t, []string{"root", "billingreport"}, messages(others))
+	assert.True(t, logger.ForComponent("billing").IsEnabled(slf4go_api.Info))
+	assert.False(t, slf4go_tee.New(slf4go_tee.WithSink(billing, slf4go_tee.OnlyComponents("billing"))).IsEnabled(slf4go_api.Info))
+}
+
+func TestTeeLogger_DerivesAllSinks(t *testing.T) {
+	first, second := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(slf4go_tee.WithSink(first), slf4go_tee.WithSink(second))
+	err := errors.New("card declined")
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"requestId": "r-1"})
+
+	logger.ForComponent("billing").WithStaticTags(slf4go_api.LogTags{"service": "billing"}).WithError(err).
+		WarnWithTagsCtxf(ctx, slf4go_api.LogTags{"orderId": 42}, "retrying order %d", 42)
+
+	for _, recorder := range []*slf4go_test.RecordingLogger{first, second} {
+		slf4go_test.AssertLog(t, recorder).HasCount(1).Last().
+			HasLevel(slf4go_api.Warn).
+			HasMessage("retrying order 42").
+			HasComponent("billing").
+			HasError(err).
+			HasTagsSubset(slf4go_api.LogTags{"service": "billing", "orderId": 42, "requestId": "r-1"})
+	}
+}
+
+func TestTeeLogger_AddStaticTags(t *testing.T) {
+	first := slf4go_test.NewRecordingLogger().WithStaticTags(slf4go_api.LogTags{"sink": "first"})
+	second := slf4go_test.NewRecordingLogger().WithStaticTags(slf4go_api.LogTags{"sink": "second"})
+	logger := slf4go_tee.New(slf4go_tee.WithSink(first), slf4go_tee.WithSink(second))
+
+	slf4go_api.AddStaticTags(logger, slf4go_api.LogTags{"service": "billing"}).Infof("test message")
+
+	slf4go_test.AssertLog(t, first.(*slf4go_test.RecordingLogger)).HasCount(1).Last().
+		HasTags(slf4go_api.LogTags{"sink": "first", "service": "billing"})
+	slf4go_test.AssertLog(t, second.(*slf4go_test.RecordingLogger)).HasCount(1).Last().
+		HasTags(slf4go_api.LogTags{"sink": "second", "service": "billing"})
+}
+
+func TestTeeLogger_Levels(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(recorder, slf4go_tee.MinLevel(slf4go_api.Debug)),
+		slf4go_tee.WithSink(slf4go_test.NewRecordingLogger(), slf4go_tee.MinLevel(slf4go_api.Info))).
+		WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Info))
+
+	assert.True(t, logger.IsEnabled(slf4go_api.Info))
+	assert.False(t, logger.IsDebugEnabled(), "the component levels apply to all sinks")
+	assert.False(t, slf4go_tee.New().IsEnabled(slf4go_api.Error))
+	logger.Debugf("discarded")
+	assert.Empty(t, recorder.Entries())
+}
+
+func TestTeeLogger_LazyValuesResolvedOnce(t *testing.T) {
+	first, second := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(slf4go_tee.WithSink(first), slf4go_tee.WithSink(second), slf4go_tee.WithSink(first, slf4go_tee.MinLevel(slf4go_api.Error)))
+	calls := 0
+	lazy := slf4go_api.Lazy(func() interface{} {
+		calls++
+		return 42
+	})
+
+	logger.InfoWithTagsf(slf4go_api.LogTags{"orderId": lazy}, "charging order %v", lazy)
+	logger.Debugf("debug %v", slf4go_api.Lazy(func() interface{} { return 43 }))
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{"charging order 42", "debug 43"}, messages(second))
+}
+
+func TestTeeLogger_FailingSink(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	var errs []error
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(failingLogger{slf4go_test.NewRecordingLogger()}),
+		slf4go_tee.WithSink(recorder),
+		slf4go_tee.WithErrorHandler(func(err error) { errs = append(errs, err) }))
+
+	logger.Errorf("charging failed")
+
+	assert.Equal(t, []string{"charging failed"}, messages(recorder))
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "sink slf4go_tee_test.failingLogger panicked: connection refused")
+}
+
+func TestTeeLogger_Fatal(t *testing.T) {
+	console, file := &bytes.Buffer{}, &bytes.Buffer{}
+	recorder := slf4go_test.NewRecordingLogger()
+	var errs []error
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(slf4go_native_provider.New(console), slf4go_tee.MinLevel(slf4go_api.Info)),
+		slf4go_tee.WithSink(slf4go_native_provider.New(file, slf4go_native_provider.WithEncoder(slf4go_native_provider.JSONEncoder{}))),
+		slf4go_tee.WithSink(recorder, slf4go_tee.OnlyComponents("shipping")),
+		slf4go_tee.WithSink(failingLogger{recorder}),
+		slf4go_tee.WithErrorHandler(func(err error) { errs = append(errs, err) }))
+
+	err := slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+		logger.Fatalf("disk %s", "full")
+	})
+
+	assert.Equal(t, &slf4go_api.FatalError{Message: "disk full", Code: slf4go_api.DefaultExitCode}, err)
+	assert.Contains(t, console.String(), "level=fatal msg=\"disk full\"")
+	assert.Contains(t, file.String(), `"level":"fatal","msg":"disk full"`)
+	assert.Empty(t, recorder.Entries(), "sink filters apply to Fatal entries")
+	assert.Empty(t, errs, "program exits and panics of Fatal entries are expected")
+}
+
+// goexitT ends the calling goroutine on Fatalf like testing.T does, without failing the test.
+type goexitT struct {
+	testing.TB
+	fatals []string
+}
+
+func (f *goexitT) Helper() {}
+
+func (f *goexitT) Fatalf(format string, args ...interface{}) {
+	f.fatals = append(f.fatals, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+func TestTeeLogger_FatalOfSinkEndingTheGoroutine(t *testing.T) {
+	fakeT := &goexitT{TB: t}
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(
+		slf4go_tee.WithSink(slf4go_test.NewTestingLogger(fakeT)),
+		slf4go_tee.WithSink(recorder))
+
+	err := slf4go_api.CatchFatal(logger, func(logger slf4go_api.Slf4GoLogger) {
+		logger.Fatalf("disk full")
+	})
+
+	assert.Equal(t, &slf4go_api.FatalError{Message: "disk full", Code: slf4go_api.DefaultExitCode}, err)
+	assert.Len(t, fakeT.fatals, 1)
+	assert.Equal(t, []string{"disk full"}, messages(recorder), "sinks after the one ending the goroutine are written")
+}
+
+func TestTeeLogger_Panic(t *testing.T) {
+	first, second := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(slf4go_tee.WithSink(first), slf4go_tee.WithSink(second))
+
+	assert.PanicsWithValue(t, "inconsistent state", func() {
+		logger.Panicf("inconsistent %s", "state")
+	})
+	assert.PanicsWithValue(t, "without sinks", func() {
+		slf4go_tee.New().Panicf("without sinks")
+	})
+
+	assert.Equal(t, []string{"inconsistent state"}, messages(first))
+	assert.Equal(t, []string{"inconsistent state"}, messages(second))
+}
+
+func TestTeeLogger_CallerReporting(t *testing.T) {
+	first, second := slf4go_test.NewRecordingLogger(), slf4go_test.NewRecordingLogger()
+	logger := slf4go_tee.New(slf4go_tee.WithSink(first), slf4go_tee.WithSink(second)).WithCallerReporting(true)
+
+	logger.Infof("test message")
+	line := currentLine() - 1
+
+	for _, recorder := range []*slf4go_test.RecordingLogger{first, second} {
+		entries := recorder.Entries()
+		require.Len(t, entries, 1)
+		assert.True(t, strings.HasSuffix(fmt.Sprint(entries[0].Tags[slf4go_api.CallerTag]), fmt.Sprintf("/slf4go_tee_test.go:%d", line)),
+			"unexpected caller %v", entries[0].Tags[slf4go_api.CallerTag])
+	}
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
@@ -0,0 +1,446 @@
+package slf4go_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// TestingT is the subset of testing.TB the assertions report failures to.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// EntrySource provides captured entries, e.g. a RecordingLogger.
+type EntrySource interface {
+	Entries() []Entry
+}
+
+// Entries adapts a slice of entries, e.g. converted from the entries captured by a logging backend, to EntrySource.
+type Entries []Entry
+
+// Entries returns the entries themselves.
+func (e Entries) Entries() []Entry {
+	return e
+}
+
+// Matcher selects entries by a single property. Matchers passed together must all match.
+type Matcher struct {
+	description string
+	matches     func(entry Entry) bool
+}
+
+// Matches reports whether the entry matches.
+func (m Matcher) Matches(entry Entry) bool {
+	return m.matches(entry)
+}
+
+// String describes the matcher in failure messages.
+func (m Matcher) String() string {
+	return m.description
+}
+
+// AtLevel matches entries of the given level.
+func AtLevel(level slf4go_api.LogLevel) Matcher {
+	return Matcher{fmt.Sprintf("level %s", level), func(entry Entry) bool {
+		return entry.Level == level
+	}}
+}
+
+// AtOrAbove matches entries at least as severe as the given level, e.g. AtOrAbove(slf4go_api.Error) matches
+// Error, Panic and Fatal entries.
+func AtOrAbove(level slf4go_api.LogLevel) Matcher {
+	return Matcher{fmt.Sprintf("level %s or above", level), func(entry Entry) bool {
+		return entry.Level <= level
+	}}
+}
+
+// WithMessage matches entries with the given rendered message.
+func WithMessage(message string) Matcher {
+	return Matcher{fmt.Sprintf("message %q", message), func(entry Entry) bool {
+		return entry.Message == message
+	}}
+}
+
+// MessageMatching matches entries whose rendered message matches the given regular expression.
+// It panics if the expression cannot be compiled.
+func MessageMatching(expression string) Matcher {
+	pattern := regexp.MustCompile(expression)
+	return Matcher{fmt.Sprintf("message matching %q", expression), func(entry Entry) bool {
+		return pattern.MatchString(entry.Message)
+	}}
+}
+
+// WithTags matches entries containing all of the given tags, besides others.
+func WithTags(tags slf4go_api.LogTags) Matcher {
+	return Matcher{fmt.Sprintf("tags %s", formatTags(tags)), func(entry Entry) bool {
+		return containsTags(entry.Tags, tags)
+	}}
+}
+
+// WithoutTags matches entries containing none of the given tag keys.
+func WithoutTags(keys ...string) Matcher {
+	return Matcher{fmt.Sprintf("without tags %v", keys), func(entry Entry) bool {
+		for _, key := range keys {
+			if _, ok := entry.Tags[key]; ok {
+				return false
+			}
+		}
+		return true
+	}}
+}
+
+// ForComponent matches entries of the given component.
+func ForComponent(component slf4go_api.AppComponent) Matcher {
+	return Matcher{fmt.Sprintf("component %q", component), func(entry Entry) bool {
+		return entry.Component == component
+	}}
+}
+
+// LogAssertions asserts on all entries captured by an EntrySource. Failures are reported to TestingT and list
+// all captured entries. All methods return the assertions to allow chaining.
+type LogAssertions struct {
+	t      TestingT
+	source EntrySource
+}
+
+// AssertLog starts asserting on the entries of the given source, e.g. AssertLog(t, recorder).HasNoEntriesAtOrAbove(slf4go_api.Error).
+func AssertLog(t TestingT, source EntrySource) *LogAssertions {
+	return &LogAssertions{t: t, source: source}
+}
+
+// HasEntry asserts that at least one entry matches all matchers.
+func (a *LogAssertions) HasEntry(matchers ...Matcher) *LogAssertions {
+	helper(a.t)
+	if len(filter(a.source.Entries(), matchers)) == 0 {
+		a.fail("no entry with %s", describe(matchers))
+	}
+	return a
+}
+
+// HasNoEntry asserts that no entry matches all matchers.
+func (a *LogAssertions) HasNoEntry(matchers ...Matcher) *LogAssertions {
+	helper(a.t)
+	if matching := filter(a.source.Entries(), matchers); len(matching) > 0 {
+		a.fail("unexpected entry with %s: %s", describe(matchers), formatEntry(matching[0]))
+	}
+	return a
+}
+
+// HasNoEntriesAtOrAbove asserts that no entry is at least as severe as the given level,
+// e.g. HasNoEntriesAtOrAbove(slf4go_api.Error) for "no errors logged".
+func (a *LogAssertions) HasNoEntriesAtOrAbove(level slf4go_api.LogLevel) *LogAssertions {
+	helper(a.t)
+	return a.HasNoEntry(AtOrAbove(level))
+}
+
+// HasCount asserts the number of entries matching all matchers, or of all entries if no matcher is given.
+func (a *LogAssertions) HasCount(expected int, matchers ...Matcher) *LogAssertions {
+	helper(a.t)
+	if actual := len(filter(a.source.Entries(), matchers)); actual != expected {
+		a.fail("expected %d entries with %s, got %d", expected, describe(matchers), actual)
+	}
+	return a
+}
+
+// HasCountsPerLevel asserts the number of entries per level. Levels missing in expected must not have entries.
+func (a *LogAssertions) HasCountsPerLevel(expected map[slf4go_api.LogLevel]int) *LogAssertions {
+	helper(a.t)
+	actual := make(map[slf4go_api.LogLevel]int)
+	for _, entry := range a.source.Entries() {
+		actual[entry.Level]++
+	}
+	wanted := make(map[slf4go_api.LogLevel]int)
+	for level, count := range expected {
+		if count > 0 {
+			wanted[level] = count
+		}
+	}
+	if !reflect.DeepEqual(wanted, actual) {
+		a.t.Errorf("entries per level differ (-expected +actual):\n%s%s", diff(formatCounts(wanted), formatCounts(actual)), a.formatEntries())
+	}
+	return a
+}
+
+// HasEntriesInOrder asserts that entries matching the given matchers were logged in this order,
+// possibly with other entries in between.
+func (a *LogAssertions) HasEntriesInOrder(matchers ...Matcher) *LogAssertions {
+	helper(a.t)
+	entries := a.source.Entries()
+	next := 0
+	for i, matcher := range matchers {
+		for next < len(entries) && !matcher.Matches(entries[next]) {
+			next++
+		}
+		if next == len(entries) {
+			a.fail("no entry with %s after the entries matching %s", matcher, describe(matchers[:i]))
+			return a
+		}
+		next++
+	}
+	return a
+}
+
+// Last starts asserting on the last entry. A missing entry is reported as failure.
+func (a *LogAssertions) Last() *EntryAssertions {
+	helper(a.t)
+	return a.Entry(len(a.source.Entries()) - 1)
+}
+
+// Entry starts asserting on the entry with the given index. A missing entry is reported as failure.
+func (a *LogAssertions) Entry(index int) *EntryAssertions {
+	helper(a.t)
+	entries := a.source.Entries()
+	if index < 0 || index >= len(entries) {
+		a.fail("no entry with index %d", index)
+		return &EntryAssertions{t: a.t, missing: true}
+	}
+	return &EntryAssertions{t: a.t, entry: entries[index]}
+}
+
+func (a *LogAssertions) fail(format string, args ...interface{}) {
+	helper(a.t)
+	a.t.Errorf("%s\n%s", fmt.Sprintf(format, args...), a.formatEntries())
+}
+
+func (a *LogAssertions) formatEntries() string {
+	entries := a.source.Entries()
+	if len(entries) == 0 {
+		return "no entries captured"
+	}
+	lines := []string{"captured entries:"}
+	for i, entry := range entries {
+		lines = append(lines, fmt.Sprintf("  %d: %s", i, formatEntry(entry)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// EntryAssertions asserts on a single entry. Failures show a diff between the expected and the actual value.
+// All methods return the assertions to allow chaining.
+type EntryAssertions struct {
+	t       TestingT
+	entry   Entry
+	missing bool
+}
+
+// HasLevel asserts the level of the entry.
+func (a *EntryAssertions) HasLevel(level slf4go_api.LogLevel) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && level != a.entry.Level {
+		a.failDiff("level", []string{level.String()}, []string{a.entry.Level.String()})
+	}
+	return a
+}
+
+// HasMessage asserts the rendered message of the entry.
+func (a *EntryAssertions) HasMessage(message string) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && message != a.entry.Message {
+		a.failDiff("message", strings.Split(message, "\n"), strings.Split(a.entry.Message, "\n"))
+	}
+	return a
+}
+
+// HasMessageMatching asserts that the rendered message of the entry matches the given regular expression.
+func (a *EntryAssertions) HasMessageMatching(expression string) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && !regexp.MustCompile(expression).MatchString(a.entry.Message) {
+		a.t.Errorf("message of %s does not match %q", formatEntry(a.entry), expression)
+	}
+	return a
+}
+
+// HasTags asserts the tags of the entry, which must neither miss one of the given tags nor contain others.
+func (a *EntryAssertions) HasTags(tags slf4go_api.LogTags) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && !(containsTags(a.entry.Tags, tags) && len(a.entry.Tags) == len(tags)) {
+		a.failDiff("tags", formatTagLines(tags), formatTagLines(a.entry.Tags))
+	}
+	return a
+}
+
+// HasTagsSubset asserts that the entry contains the given tags, besides others.
+func (a *EntryAssertions) HasTagsSubset(tags slf4go_api.LogTags) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && !containsTags(a.entry.Tags, tags) {
+		subset := make(slf4go_api.LogTags, len(tags))
+		for key := range tags {
+			if value, ok := a.entry.Tags[key]; ok {
+				subset[key] = value
+			}
+		}
+		a.failDiff("tags", formatTagLines(tags), formatTagLines(subset))
+	}
+	return a
+}
+
+// HasNoTags asserts that the entry contains none of the given tag keys.
+func (a *EntryAssertions) HasNoTags(keys ...string) *EntryAssertions {
+	helper(a.t)
+	if !a.missing {
+		for _, key := range keys {
+			if value, ok := a.entry.Tags[key]; ok {
+				a.t.Errorf("unexpected tag %s=%v in %s", key, value, formatEntry(a.entry))
+			}
+		}
+	}
+	return a
+}
+
+// HasComponent asserts the component of the entry.
+func (a *EntryAssertions) HasComponent(component slf4go_api.AppComponent) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && component != a.entry.Component {
+		a.failDiff("component", []string{string(component)}, []string{string(a.entry.Component)})
+	}
+	return a
+}
+
+// HasError asserts that the error attached to the entry is or wraps the given error (see errors.Is).
+func (a *EntryAssertions) HasError(err error) *EntryAssertions {
+	helper(a.t)
+	if !a.missing && !errors.Is(a.entry.Err, err) {
+		a.t.Errorf("expected error %v, got %v in %s", err, a.entry.Err, formatEntry(a.entry))
+	}
+	return a
+}
+
+// failDiff reports that the given property of the entry differs from the expected one, as diff of their lines.
+func (a *EntryAssertions) failDiff(property string, expected []string, actual []string) {
+	helper(a.t)
+	a.t.Errorf("%s of %s differ (-expected +actual):\n%s", property, formatEntry(a.entry), diff(expected, actual))
+}
+
+func helper(t TestingT) {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+}
+
+func filter(entries []Entry, matchers []Matcher) []Entry {
+	var matching []Entry
+	for _, entry := range entries {
+		if matchesAll(entry, matchers) {
+			matching = append(matching, entry)
+		}
+	}
+	return matching
+}
+
+func matchesAll(entry Entry, matchers []Matcher) bool {
+	for _, matcher := range matchers {
+		if !matcher.Matches(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsTags(actual slf4go_api.LogTags, expected slf4go_api.LogTags) bool {
+	for key, value := range expected {
+		if actualValue, ok := actual[key]; !ok || !reflect.DeepEqual(value, actualValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func describe(matchers []Matcher) string {
+	if len(matchers) == 0 {
+		return "any properties"
+	}
+	descriptions := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		descriptions[i] = matcher.String()
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+func formatEntry(entry Entry) string {
+	formatted := fmt.Sprintf("%s %q", entry.Level, entry.Message)
+	if entry.Component != "" {
+		formatted += fmt.Sprintf(" component=%q", entry.Component)
+	}
+	if len(entry.Tags) > 0 {
+		formatted += " tags=" + formatTags(entry.Tags)
+	}
+	return formatted
+}
+
+func formatTags(tags slf4go_api.LogTags) string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", key, tags[key])
+	}
+	return "{" + strings.Join(pairs, " ") + "}"
+}
+
+// formatTagLines renders one line per tag, sorted by key and including the type of the value, so that failures show
+// a readable diff.
+func formatTagLines(tags slf4go_api.LogTags) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	lines := make([]string, len(keys))
+	for i, key := range keys {
+		lines[i] = fmt.Sprintf("%s=%#v (%T)", key, tags[key], tags[key])
+	}
+	return lines
+}
+
+// formatCounts renders counts per level in descending severity, so that failures show a readable diff.
+func formatCounts(counts map[slf4go_api.LogLevel]int) []string {
+	var lines []string
+	for _, level := range slf4go_api.AllLevels {
+		if counts[level] > 0 {
+			lines = append(lines, fmt.Sprintf("%s: %d", level, counts[level]))
+		}
+	}
+	return lines
+}
+
+// diff renders the lines of expected and actual as line diff, prefixing lines only expected with '-' and lines
+// only present in actual with '+'. The lines common to both are found as longest common subsequence.
+func diff(expected []string, actual []string) string {
+	common := make([][]int, len(expected)+1)
+	for i := range common {
+		common[i] = make([]int, len(actual)+1)
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			if expected[i] == actual[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+	var rendered strings.Builder
+	i, j := 0, 0
+	for i < len(expected) || j < len(actual) {
+		switch {
+		case i < len(expected) && j < len(actual) && expected[i] == actual[j]:
+			rendered.WriteString(" " + expected[i] + "\n")
+			i, j = i+1, j+1
+		case j == len(actual) || (i < len(expected) && common[i+1][j] >= common[i][j+1]):
+			rendered.WriteString("-" + expected[i] + "\n")
+			i++
+		default:
+			rendered.WriteString("+" + actual[j] + "\n")
+			j++
+		}
+	}
+	return rendered.String()
+}
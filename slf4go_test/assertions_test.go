@@ -0,0 +1,163 @@
+package slf4go_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// failureRecorder captures the failures reported by the assertions under test.
+type failureRecorder struct {
+	failures []string
+}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+var errDeclined = errors.New("card declined")
+
+func newRecordedLog() *RecordingLogger {
+	logger := NewRecordingLogger()
+	logger.Infof("server started on port %d", 8080)
+	billing := logger.ForComponent("billing")
+	billing.InfoWithTagsf(slf4go_api.LogTags{"orderId": 42, "amount": "9.99"}, "charging order %d", 42)
+	billing.WarnErrf(fmt.Errorf("charging failed: %w", errDeclined), "retrying order %d", 42)
+	billing.InfoWithTagsf(slf4go_api.LogTags{"orderId": 42}, "order %d charged", 42)
+	return logger
+}
+
+func TestLogAssertions_Passing(t *testing.T) {
+	failures := &failureRecorder{}
+
+	AssertLog(failures, newRecordedLog()).
+		HasEntry(AtLevel(slf4go_api.Warn), ForComponent("billing")).
+		HasEntry(MessageMatching(`^charging order \d+$`), WithTags(slf4go_api.LogTags{"orderId": 42})).
+		HasEntry(WithMessage("server started on port 8080"), WithoutTags("orderId")).
+		HasNoEntry(AtLevel(slf4go_api.Debug)).
+		HasNoEntriesAtOrAbove(slf4go_api.Error).
+		HasCount(4).
+		HasCount(2, AtLevel(slf4go_api.Info), ForComponent("billing")).
+		HasCountsPerLevel(map[slf4go_api.LogLevel]int{slf4go_api.Info: 3, slf4go_api.Warn: 1, slf4go_api.Error: 0}).
+		HasEntriesInOrder(WithMessage("charging order 42"), AtLevel(slf4go_api.Warn), WithMessage("order 42 charged"))
+
+	AssertLog(failures, newRecordedLog()).Entry(2).
+		HasLevel(slf4go_api.Warn).
+		HasMessage("retrying order 42").
+		HasMessageMatching(`order \d+`).
+		HasComponent("billing").
+		HasError(errDeclined).
+		HasTagsSubset(slf4go_api.LogTags{slf4go_api.ErrorTag: "charging failed: card declined"}).
+		HasNoTags("orderId")
+
+	AssertLog(failures, newRecordedLog()).Last().
+		HasTags(slf4go_api.LogTags{"orderId": 42})
+
+	assert.Empty(t, failures.failures)
+}
+
+func TestLogAssertions_Failing(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		assert   func(a *LogAssertions)
+		expected []string
+	}{
+		{
+			name:     "has-entry",
+			assert:   func(a *LogAssertions) { a.HasEntry(AtLevel(slf4go_api.Error), ForComponent("billing")) },
+			expected: []string{"no entry with level error, component \"billing\"", "captured entries:", `2: warning "retrying order 42" component="billing"`},
+		},
+		{
+			name:     "has-no-entry",
+			assert:   func(a *LogAssertions) { a.HasNoEntry(WithTags(slf4go_api.LogTags{"orderId": 42})) },
+			expected: []string{`unexpected entry with tags {orderId=42}: info "charging order 42" component="billing" tags={amount=9.99 orderId=42}`},
+		},
+		{
+			name:     "has-no-entries-at-or-above",
+			assert:   func(a *LogAssertions) { a.HasNoEntriesAtOrAbove(slf4go_api.Warn) },
+			expected: []string{`unexpected entry with level warning or above: warning "retrying order 42"`},
+		},
+		{
+			name:     "has-count",
+			assert:   func(a *LogAssertions) { a.HasCount(1, AtLevel(slf4go_api.Info)) },
+			expected: []string{"expected 1 entries with level info, got 3"},
+		},
+		{
+			name:     "has-counts-per-level",
+			assert:   func(a *LogAssertions) { a.HasCountsPerLevel(map[slf4go_api.LogLevel]int{slf4go_api.Info: 3}) },
+			expected: []string{"+warning: 1\n info: 3\n", "entries per level"},
+		},
+		{
+			name: "has-entries-in-order",
+			assert: func(a *LogAssertions) {
+				a.HasEntriesInOrder(WithMessage("order 42 charged"), WithMessage("charging order 42"))
+			},
+			expected: []string{`no entry with message "charging order 42" after the entries matching message "order 42 charged"`},
+		},
+		{
+			name:     "missing-entry",
+			assert:   func(a *LogAssertions) { a.Entry(4).HasLevel(slf4go_api.Info).HasMessage("never logged") },
+			expected: []string{"no entry with index 4"},
+		},
+		{
+			name:     "entry-level",
+			assert:   func(a *LogAssertions) { a.Last().HasLevel(slf4go_api.Debug).HasMessage("order 42 charged") },
+			expected: []string{"-debug\n+info\n", `level of info "order 42 charged"`},
+		},
+		{
+			name:     "entry-message",
+			assert:   func(a *LogAssertions) { a.Last().HasMessage("order 43 charged") },
+			expected: []string{"-order 43 charged\n", "+order 42 charged\n"},
+		},
+		{
+			name:     "entry-tags",
+			assert:   func(a *LogAssertions) { a.Entry(1).HasTagsSubset(slf4go_api.LogTags{"orderId": 43, "currency": "EUR"}) },
+			expected: []string{"-currency=\"EUR\" (string)\n-orderId=43 (int)\n+orderId=42 (int)\n"},
+		},
+		{
+			name:     "entry-tag-types",
+			assert:   func(a *LogAssertions) { a.Last().HasTags(slf4go_api.LogTags{"orderId": int64(42)}) },
+			expected: []string{"-orderId=42 (int64)\n+orderId=42 (int)\n"},
+		},
+		{
+			name:     "entry-no-tags",
+			assert:   func(a *LogAssertions) { a.Entry(1).HasNoTags("amount") },
+			expected: []string{"unexpected tag amount=9.99"},
+		},
+		{
+			name:     "entry-error",
+			assert:   func(a *LogAssertions) { a.Entry(1).HasError(errDeclined) },
+			expected: []string{"expected error card declined, got <nil>"},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			failures := &failureRecorder{}
+
+			scenario.assert(AssertLog(failures, newRecordedLog()))
+
+			require.Len(t, failures.failures, 1)
+			for _, expected := range scenario.expected {
+				assert.Contains(t, failures.failures[0], expected)
+			}
+		})
+	}
+}
+
+func TestLogAssertions_Entries(t *testing.T) {
+	failures := &failureRecorder{}
+	entries := Entries{{Level: slf4go_api.Error, Message: "converted from a backend"}}
+
+	AssertLog(failures, entries).HasCount(1).Last().HasLevel(slf4go_api.Error)
+	AssertLog(failures, Entries{}).HasNoEntriesAtOrAbove(slf4go_api.Trace).HasEntry()
+
+	require.Len(t, failures.failures, 1)
+	assert.True(t, strings.Contains(failures.failures[0], "no entries captured"), failures.failures[0])
+}
@@ -0,0 +1,373 @@
+package slf4go_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// ErrFatal is the value a RecordingLogger panics with after recording a Fatal entry, instead of terminating the
+// test binary. Like the program exit of a real logger, it stops the code under test.
+var ErrFatal = errors.New("slf4go_test: Fatal entry logged")
+
+// Entry is a log entry captured by a RecordingLogger, normalized independently of the logging method used,
+// e.g. Infof, InfoWithTagsf and LogCtxf(ctx, slf4go_api.Info, ...) all yield the same kind of Entry.
+type Entry struct {
+	Level slf4go_api.LogLevel
+	// Message is the rendered message, the template formatted with the args.
+	Message  string
+	Template string
+	// Args are the arguments the template was formatted with, lazy values already resolved.
+	Args []interface{}
+	// Tags contains the static tags, the error tags, caller and stack trace tags, the context tags and the dynamic
+	// tags, lazy values already resolved. Later tags win on conflicting keys, in the order listed, as with the
+	// providers. Like with the providers, WithStaticTags replaces the static tags instead of adding to them.
+	Tags      slf4go_api.LogTags
+	Component slf4go_api.AppComponent
+	// Err is the error attached via WithError or one of the Errf methods.
+	Err error
+}
+
+// RecordingLogger implements slf4go_api.Slf4GoLogger by capturing all entries in memory, to be queried by tests
+// instead of setting up expectations for each logging method. Loggers derived from a RecordingLogger record
+// into the same entries. RecordingLogger is safe for concurrent use.
+//
+// All levels are recorded unless restricted via WithComponentLevels. Panic entries panic with the rendered message
+// after being recorded, Fatal entries panic with ErrFatal.
+type RecordingLogger struct {
+	recording       *recording
+	appComponent    slf4go_api.AppComponent
+	tags            slf4go_api.LogTags
+	err             error
+	reportCaller    bool
+	stackTraceLevel slf4go_api.LogLevel
+	componentLevels *slf4go_api.ComponentLevels
+}
+
+// recording holds the entries of a RecordingLogger and all loggers derived from it.
+type recording struct {
+	mutex   sync.RWMutex
+	entries []Entry
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(RecordingLogger{}).PkgPath())
+}
+
+// NewRecordingLogger creates a new RecordingLogger without entries.
+func NewRecordingLogger() *RecordingLogger {
+	return &RecordingLogger{
+		recording:       &recording{},
+		tags:            make(slf4go_api.LogTags),
+		stackTraceLevel: slf4go_api.NoStackTrace,
+	}
+}
+
+// Entries returns a copy of all entries recorded so far, in the order they were logged.
+func (l *RecordingLogger) Entries() []Entry {
+	l.recording.mutex.RLock()
+	defer l.recording.mutex.RUnlock()
+	return append([]Entry(nil), l.recording.entries...)
+}
+
+// Filter returns the entries recorded so far with the given level, in the order they were logged.
+func (l *RecordingLogger) Filter(level slf4go_api.LogLevel) []Entry {
+	var filtered []Entry
+	for _, entry := range l.Entries() {
+		if entry.Level == level {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// Reset discards all entries recorded so far.
+func (l *RecordingLogger) Reset() {
+	l.recording.mutex.Lock()
+	defer l.recording.mutex.Unlock()
+	l.recording.entries = nil
+}
+
+func (l *RecordingLogger) derive() *RecordingLogger {
+	derived := *l
+	return &derived
+}
+
+func (l *RecordingLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	derived := l.derive()
+	derived.appComponent = component
+	return derived
+}
+
+// WithAppComponentLabel returns the logger itself, as the component is recorded in Entry.Component regardless of its label.
+func (l *RecordingLogger) WithAppComponentLabel(string) slf4go_api.Slf4GoLogger {
+	return l
+}
+
+// WithStaticTags replaces the static tags of the logger, like the providers do.
+func (l *RecordingLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.tags = tags
+	return derived
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *RecordingLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *RecordingLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.err = err
+	return derived
+}
+
+func (l *RecordingLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.reportCaller = enabled
+	return derived
+}
+
+func (l *RecordingLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.stackTraceLevel = threshold
+	return derived
+}
+
+func (l *RecordingLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	derived := l.derive()
+	derived.componentLevels = levels
+	return derived
+}
+
+func (l *RecordingLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	return level <= slf4go_api.Trace && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *RecordingLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *RecordingLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *RecordingLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.record(level, nil, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.record(level, tags, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.record(level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *RecordingLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.record(level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+// record captures the entry if its level is enabled. Fatal and Panic entries panic regardless of the level.
+func (l *RecordingLogger) record(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	enabled := l.IsEnabled(level)
+	if !enabled && level != slf4go_api.Fatal && level != slf4go_api.Panic {
+		return
+	}
+	resolvedArgs := slf4go_api.ResolveArgs(args)
+	msg := fmt.Sprintf(msgTemplate, resolvedArgs...)
+	if enabled {
+		if l.reportCaller {
+			tags = combineTags(slf4go_api.CallerTags(), tags)
+		}
+		if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+			tags = combineTags(slf4go_api.StackTraceTags(), tags)
+		}
+		entry := Entry{
+			Level:     level,
+			Message:   msg,
+			Template:  msgTemplate,
+			Args:      resolvedArgs,
+			Tags:      slf4go_api.ResolveTags(combineTags(l.tags, slf4go_api.ErrorTags(l.err), tags)),
+			Component: l.appComponent,
+			Err:       l.err,
+		}
+		l.recording.mutex.Lock()
+		l.recording.entries = append(l.recording.entries, entry)
+		l.recording.mutex.Unlock()
+	}
+	switch level {
+	case slf4go_api.Fatal:
+		panic(ErrFatal)
+	case slf4go_api.Panic:
+		panic(msg)
+	}
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *RecordingLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *RecordingLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
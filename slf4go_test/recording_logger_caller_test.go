@@ -0,0 +1,76 @@
+package slf4go_test_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_test"
+)
+
+// The caller tests live in an external test package, as frames of the slf4go_test package itself are skipped
+// when determining the call site.
+
+func TestCallerReporting(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := recorder.WithCallerReporting(true)
+	ctx := context.Background()
+	tags := slf4go_api.LogTags{"key1": "val1"}
+
+	scenarios := []struct {
+		name  string
+		logFn func()
+		line  int
+	}{
+		{"Infof", func() { logger.Infof("test message") }, currentLine()},
+		{"LogWithTagsf", func() { logger.LogWithTagsf(slf4go_api.Info, tags, "test message") }, currentLine()},
+		{"WarnWithTagsCtxf", func() { logger.WarnWithTagsCtxf(ctx, tags, "test message") }, currentLine()},
+		{"ErrorErrf", func() { logger.ErrorErrf(errors.New("test error"), "test message") }, currentLine()},
+		{"derived", func() { logger.ForComponent("test-service").Infof("test message") }, currentLine()},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			recorder.Reset()
+			scenario.logFn()
+			entry := lastEntry(t, recorder)
+			assert.True(t, strings.HasSuffix(fmt.Sprint(entry.Tags[slf4go_api.CallerTag]), fmt.Sprintf("/recording_logger_caller_test.go:%d", scenario.line)),
+				"unexpected caller %v", entry.Tags[slf4go_api.CallerTag])
+			assert.True(t, strings.HasPrefix(fmt.Sprint(entry.Tags[slf4go_api.FunctionTag]), "github.com/MariusSchmidt/slf4go/slf4go_test_test.TestCallerReporting"),
+				"unexpected function %v", entry.Tags[slf4go_api.FunctionTag])
+		})
+	}
+}
+
+func TestStackTrace(t *testing.T) {
+	recorder := slf4go_test.NewRecordingLogger()
+	logger := recorder.WithStackTrace(slf4go_api.Error)
+
+	logger.Errorf("test message")
+	stackTrace, ok := lastEntry(t, recorder).Tags[slf4go_api.StackTraceTag].(slf4go_api.StackTrace)
+	if assert.True(t, ok) && assert.NotEmpty(t, stackTrace) {
+		assert.True(t, strings.HasPrefix(stackTrace[0].Function, "github.com/MariusSchmidt/slf4go/slf4go_test_test.TestStackTrace"),
+			"unexpected frame %v", stackTrace[0])
+	}
+
+	logger.Warnf("test message")
+	assert.NotContains(t, lastEntry(t, recorder).Tags, slf4go_api.StackTraceTag)
+}
+
+func lastEntry(t *testing.T, recorder *slf4go_test.RecordingLogger) slf4go_test.Entry {
+	entries := recorder.Entries()
+	require.NotEmpty(t, entries)
+	return entries[len(entries)-1]
+}
+
+func currentLine() int {
+	_, _, line, _ := runtime.Caller(1)
+	return line
+}
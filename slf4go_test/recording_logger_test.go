@@ -0,0 +1,168 @@
+package slf4go_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+func TestRecordingLogger_NormalizesEntries(t *testing.T) {
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"requestId": "r-1"})
+	tags := slf4go_api.LogTags{"key": "value"}
+	expectedTags := slf4go_api.LogTags{"key": "value"}
+
+	scenarios := []struct {
+		name         string
+		log          func(logger slf4go_api.Slf4GoLogger)
+		expectedTags slf4go_api.LogTags
+	}{
+		{"Infof", func(logger slf4go_api.Slf4GoLogger) { logger.Infof("user %s", "alice") }, slf4go_api.LogTags{}},
+		{"Logf", func(logger slf4go_api.Slf4GoLogger) { logger.Logf(slf4go_api.Info, "user %s", "alice") }, slf4go_api.LogTags{}},
+		{"InfoWithTagsf", func(logger slf4go_api.Slf4GoLogger) { logger.InfoWithTagsf(tags, "user %s", "alice") }, expectedTags},
+		{"LogWithTagsf", func(logger slf4go_api.Slf4GoLogger) { logger.LogWithTagsf(slf4go_api.Info, tags, "user %s", "alice") }, expectedTags},
+		{"InfoCtxf", func(logger slf4go_api.Slf4GoLogger) { logger.InfoCtxf(ctx, "user %s", "alice") }, slf4go_api.LogTags{"requestId": "r-1"}},
+		{"LogWithTagsCtxf", func(logger slf4go_api.Slf4GoLogger) {
+			logger.LogWithTagsCtxf(ctx, slf4go_api.Info, tags, "user %s", "alice")
+		}, slf4go_api.LogTags{"requestId": "r-1", "key": "value"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			logger := NewRecordingLogger()
+
+			scenario.log(logger)
+
+			assert.Equal(t, []Entry{{
+				Level:    slf4go_api.Info,
+				Message:  "user alice",
+				Template: "user %s",
+				Args:     []interface{}{"alice"},
+				Tags:     scenario.expectedTags,
+			}}, logger.Entries())
+		})
+	}
+}
+
+func TestRecordingLogger_DerivedLoggers(t *testing.T) {
+	logger := NewRecordingLogger()
+	err := fmt.Errorf("charging failed: %w", errors.New("card declined"))
+
+	logger.ForComponent("billing").
+		WithStaticTags(slf4go_api.LogTags{"service": "billing", "key": "static"}).
+		WithError(err).
+		WarnWithTagsf(slf4go_api.LogTags{"key": "dynamic", "lazy": slf4go_api.Lazy(func() interface{} { return 42 })}, "retrying")
+
+	require.Len(t, logger.Entries(), 1)
+	entry := logger.Entries()[0]
+	assert.Equal(t, slf4go_api.AppComponent("billing"), entry.Component)
+	assert.Equal(t, err, entry.Err)
+	assert.Equal(t, slf4go_api.LogTags{
+		"service":                "billing",
+		"key":                    "dynamic",
+		"lazy":                   42,
+		slf4go_api.ErrorTag:      "charging failed: card declined",
+		slf4go_api.ErrorChainTag: []string{"charging failed: card declined", "card declined"},
+	}, entry.Tags)
+}
+
+func TestRecordingLogger_WithStaticTagsReplacesTags(t *testing.T) {
+	logger := NewRecordingLogger()
+
+	logger.WithStaticTags(slf4go_api.LogTags{"service": "billing", "version": 1}).
+		WithStaticTags(slf4go_api.LogTags{"version": 2}).
+		Infof("replaced")
+
+	require.Len(t, logger.Entries(), 1)
+	assert.Equal(t, slf4go_api.LogTags{"version": 2}, logger.Entries()[0].Tags)
+}
+
+func TestRecordingLogger_Queries(t *testing.T) {
+	logger := NewRecordingLogger()
+	logger.Infof("first")
+	logger.Errorf("second")
+	logger.ForComponent("billing").Infof("third")
+
+	t.Run("entries", func(t *testing.T) {
+		entries := logger.Entries()
+		require.Len(t, entries, 3)
+		assert.Equal(t, []string{"first", "second", "third"}, []string{entries[0].Message, entries[1].Message, entries[2].Message})
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		filtered := logger.Filter(slf4go_api.Info)
+		require.Len(t, filtered, 2)
+		assert.Equal(t, "first", filtered[0].Message)
+		assert.Equal(t, "third", filtered[1].Message)
+		assert.Empty(t, logger.Filter(slf4go_api.Debug))
+	})
+
+	t.Run("entries-are-a-copy", func(t *testing.T) {
+		entries := logger.Entries()
+		entries[0].Message = "changed"
+		assert.Equal(t, "first", logger.Entries()[0].Message)
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		logger.Reset()
+		assert.Empty(t, logger.Entries())
+	})
+}
+
+func TestRecordingLogger_Levels(t *testing.T) {
+	levels := slf4go_api.NewComponentLevels(slf4go_api.Info)
+	logger := NewRecordingLogger()
+	restricted := logger.WithComponentLevels(levels)
+
+	assert.True(t, logger.IsTraceEnabled())
+	assert.False(t, restricted.IsDebugEnabled())
+	restricted.Debugf("discarded")
+	logger.Tracef("recorded")
+	logger.Logf(slf4go_api.LogLevel(666), "unknown level")
+
+	assert.Equal(t, []Entry{{Level: slf4go_api.Trace, Message: "recorded", Template: "recorded", Tags: slf4go_api.LogTags{}}}, logger.Entries())
+}
+
+func TestRecordingLogger_FatalAndPanic(t *testing.T) {
+	logger := NewRecordingLogger()
+
+	assert.PanicsWithValue(t, "stopped 1", func() {
+		logger.Panicf("stopped %d", 1)
+	})
+	assert.PanicsWithValue(t, ErrFatal, func() {
+		logger.FatalErrf(errors.New("disk full"), "stopped")
+	})
+
+	entries := logger.Entries()
+	require.Len(t, entries, 2)
+	assert.Equal(t, slf4go_api.Panic, entries[0].Level)
+	assert.Equal(t, slf4go_api.Fatal, entries[1].Level)
+	assert.Equal(t, "disk full", entries[1].Tags[slf4go_api.ErrorTag])
+}
+
+func TestRecordingLogger_Concurrency(t *testing.T) {
+	logger := NewRecordingLogger()
+	const goroutines, entries = 8, 100
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			componentLogger := logger.ForComponent(slf4go_api.AppComponent(fmt.Sprintf("component-%d", g)))
+			for i := 0; i < entries; i++ {
+				componentLogger.Infof("entry %d", i)
+				_ = logger.Filter(slf4go_api.Info)
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	assert.Len(t, logger.Entries(), goroutines*entries)
+}
@@ -0,0 +1,394 @@
+package slf4go_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// TestingLogger implements slf4go_api.Slf4GoLogger by writing entries through testing.TB.Log, so that they are
+// attributed to the test and the line that logged them, only shown for failed tests or with -v, and interleaved
+// with the output of subtests. Entries are formatted as level, quoted message, component and tags.
+//
+// Fatal and Panic entries end the test via Fatalf instead of terminating the program or panicking. As Fatalf,
+// they must only be logged from the goroutine running the test. Entries logged after the test completed,
+// e.g. by background goroutines, are discarded silently.
+type TestingLogger struct {
+	t               testing.TB
+	completion      *completion
+	minLevel        slf4go_api.LogLevel
+	failLevel       slf4go_api.LogLevel
+	failEnabled     bool
+	appComponent    slf4go_api.AppComponent
+	tags            slf4go_api.LogTags
+	err             error
+	reportCaller    bool
+	stackTraceLevel slf4go_api.LogLevel
+	componentLevels *slf4go_api.ComponentLevels
+}
+
+// completion tracks whether the test of a TestingLogger and all loggers derived from it completed. Writing an entry
+// holds the mutex, so that no entry is written through the test once the cleanup marked it as completed.
+type completion struct {
+	mutex sync.Mutex
+	done  bool
+}
+
+// TestingOption configures a TestingLogger created by NewTestingLogger.
+type TestingOption func(logger *TestingLogger)
+
+// WithTestingMinLevel sets the least severe level that is still written. Defaults to slf4go_api.Trace.
+func WithTestingMinLevel(level slf4go_api.LogLevel) TestingOption {
+	return func(logger *TestingLogger) {
+		logger.minLevel = level
+	}
+}
+
+// FailAtOrAbove marks the test as failed via Errorf for every entry at least as severe as the given level,
+// e.g. FailAtOrAbove(slf4go_api.Error) for tests of code that must not log errors. The test continues.
+func FailAtOrAbove(level slf4go_api.LogLevel) TestingOption {
+	return func(logger *TestingLogger) {
+		logger.failLevel = level
+		logger.failEnabled = true
+	}
+}
+
+// NewTestingLogger creates a new TestingLogger writing through the given test.
+func NewTestingLogger(t testing.TB, options ...TestingOption) *TestingLogger {
+	logger := &TestingLogger{
+		t:               t,
+		completion:      &completion{},
+		minLevel:        slf4go_api.Trace,
+		tags:            make(slf4go_api.LogTags),
+		stackTraceLevel: slf4go_api.NoStackTrace,
+	}
+	for _, option := range options {
+		option(logger)
+	}
+	t.Cleanup(func() {
+		logger.completion.mutex.Lock()
+		defer logger.completion.mutex.Unlock()
+		logger.completion.done = true
+	})
+	return logger
+}
+
+func (l *TestingLogger) derive() *TestingLogger {
+	derived := *l
+	return &derived
+}
+
+func (l *TestingLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	derived := l.derive()
+	derived.appComponent = component
+	return derived
+}
+
+// WithAppComponentLabel returns the logger itself, as the component is written regardless of its label.
+func (l *TestingLogger) WithAppComponentLabel(string) slf4go_api.Slf4GoLogger {
+	return l
+}
+
+// WithStaticTags replaces the static tags of the logger, like the providers do.
+func (l *TestingLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.tags = tags
+	return derived
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *TestingLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *TestingLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.err = err
+	return derived
+}
+
+func (l *TestingLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.reportCaller = enabled
+	return derived
+}
+
+func (l *TestingLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	derived := l.derive()
+	derived.stackTraceLevel = threshold
+	return derived
+}
+
+func (l *TestingLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	derived := l.derive()
+	derived.componentLevels = levels
+	return derived
+}
+
+func (l *TestingLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	return level <= l.minLevel && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *TestingLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *TestingLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *TestingLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.log(level, nil, msgTemplate, args...)
+}
+
+func (l *TestingLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.log(level, tags, msgTemplate, args...)
+}
+
+func (l *TestingLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *TestingLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.log(level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *TestingLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.log(level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+// log writes the entry if its level is enabled. Fatal and Panic entries end the test regardless of the level.
+func (l *TestingLogger) log(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	enabled := l.IsEnabled(level)
+	if !enabled && level != slf4go_api.Fatal && level != slf4go_api.Panic {
+		return
+	}
+	resolvedArgs := slf4go_api.ResolveArgs(args)
+	msg := fmt.Sprintf(msgTemplate, resolvedArgs...)
+	entry := Entry{Level: level, Message: msg, Template: msgTemplate, Args: resolvedArgs, Component: l.appComponent, Err: l.err}
+	if enabled {
+		if l.reportCaller {
+			tags = combineTags(slf4go_api.CallerTags(), tags)
+		}
+		if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+			tags = combineTags(slf4go_api.StackTraceTags(), tags)
+		}
+		entry.Tags = slf4go_api.ResolveTags(combineTags(l.tags, slf4go_api.ErrorTags(l.err), tags))
+	}
+	l.completion.mutex.Lock()
+	// unlocked via defer, as Fatalf ends the goroutine
+	defer l.completion.mutex.Unlock()
+	if l.completion.done {
+		return
+	}
+	switch {
+	case level == slf4go_api.Fatal || level == slf4go_api.Panic:
+		l.t.Fatalf("%s", formatEntry(entry))
+	case l.failEnabled && level <= l.failLevel:
+		l.t.Errorf("%s", formatEntry(entry))
+	default:
+		l.t.Log(formatEntry(entry))
+	}
+}
+
+func (l *TestingLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *TestingLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *TestingLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *TestingLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *TestingLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *TestingLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *TestingLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *TestingLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *TestingLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *TestingLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *TestingLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *TestingLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *TestingLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *TestingLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.t.Helper()
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
@@ -0,0 +1,212 @@
+package slf4go_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+)
+
+// fakeT captures the output of a TestingLogger. Fatalf ends the calling goroutine like testing.T does.
+type fakeT struct {
+	testing.TB
+	logs     []string
+	errors   []string
+	fatals   []string
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Name() string {
+	return "TestFake"
+}
+
+func (f *fakeT) Cleanup(cleanup func()) {
+	f.cleanups = append(f.cleanups, cleanup)
+}
+
+func (f *fakeT) Log(args ...interface{}) {
+	f.logs = append(f.logs, fmt.Sprint(args...))
+}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.fatals = append(f.fatals, fmt.Sprintf(format, args...))
+	runtime.Goexit()
+}
+
+// inTestGoroutine runs logFn in its own goroutine, so that Fatalf only ends that goroutine.
+func inTestGoroutine(logFn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		logFn()
+	}()
+	<-done
+}
+
+func TestTestingLogger(t *testing.T) {
+	ctx := slf4go_api.ContextWithTags(context.Background(), slf4go_api.LogTags{"requestId": "r-1"})
+
+	scenarios := []struct {
+		name     string
+		options  []TestingOption
+		logFn    func(logger slf4go_api.Slf4GoLogger)
+		expected fakeT
+	}{
+		{
+			name:     "info",
+			logFn:    func(logger slf4go_api.Slf4GoLogger) { logger.Infof("server started on port %d", 8080) },
+			expected: fakeT{logs: []string{`info "server started on port 8080"`}},
+		},
+		{
+			name: "component-and-tags",
+			logFn: func(logger slf4go_api.Slf4GoLogger) {
+				logger.ForComponent("billing").WithStaticTags(slf4go_api.LogTags{"service": "billing"}).
+					DebugWithTagsCtxf(ctx, slf4go_api.LogTags{"orderId": 42}, "charging order")
+			},
+			expected: fakeT{logs: []string{`debug "charging order" component="billing" tags={orderId=42 requestId=r-1 service=billing}`}},
+		},
+		{
+			name:     "min-level",
+			options:  []TestingOption{WithTestingMinLevel(slf4go_api.Info)},
+			logFn:    func(logger slf4go_api.Slf4GoLogger) { logger.Debugf("discarded") },
+			expected: fakeT{},
+		},
+		{
+			name:     "error-without-fail-level",
+			logFn:    func(logger slf4go_api.Slf4GoLogger) { logger.ErrorErrf(errors.New("card declined"), "charging failed") },
+			expected: fakeT{logs: []string{`error "charging failed" tags={error=card declined}`}},
+		},
+		{
+			name:    "fail-at-or-above",
+			options: []TestingOption{FailAtOrAbove(slf4go_api.Warn)},
+			logFn: func(logger slf4go_api.Slf4GoLogger) {
+				logger.Infof("charging order")
+				logger.Warnf("retrying")
+				logger.Errorf("charging failed")
+			},
+			expected: fakeT{logs: []string{`info "charging order"`}, errors: []string{`warning "retrying"`, `error "charging failed"`}},
+		},
+		{
+			name: "fatal",
+			logFn: func(logger slf4go_api.Slf4GoLogger) {
+				logger.Fatalf("disk full")
+				logger.Infof("not reached")
+			},
+			expected: fakeT{fatals: []string{`fatal "disk full"`}},
+		},
+		{
+			name: "panic",
+			logFn: func(logger slf4go_api.Slf4GoLogger) {
+				logger.PanicWithTagsf(slf4go_api.LogTags{"key": "value"}, "inconsistent state")
+				logger.Infof("not reached")
+			},
+			expected: fakeT{fatals: []string{`panic "inconsistent state" tags={key=value}`}},
+		},
+		{
+			name:    "panic-below-min-level",
+			options: []TestingOption{WithTestingMinLevel(slf4go_api.Fatal)},
+			logFn: func(logger slf4go_api.Slf4GoLogger) {
+				logger.WithComponentLevels(slf4go_api.NewComponentLevels(slf4go_api.Fatal)).Panicf("stopped")
+			},
+			expected: fakeT{fatals: []string{`panic "stopped"`}},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			fake := &fakeT{}
+			logger := NewTestingLogger(fake, scenario.options...)
+
+			inTestGoroutine(func() { scenario.logFn(logger) })
+
+			assert.Equal(t, scenario.expected.logs, fake.logs)
+			assert.Equal(t, scenario.expected.errors, fake.errors)
+			assert.Equal(t, scenario.expected.fatals, fake.fatals)
+		})
+	}
+}
+
+func TestTestingLogger_DisabledWithoutExtractingContextTags(t *testing.T) {
+	fake := &fakeT{}
+	logger := NewTestingLogger(fake, WithTestingMinLevel(slf4go_api.Info))
+	extractions := 0
+	unregister := slf4go_api.RegisterContextTagExtractor(func(ctx context.Context) slf4go_api.LogTags {
+		extractions++
+		return nil
+	})
+	defer unregister()
+
+	logger.DebugCtxf(context.Background(), "discarded")
+	logger.TraceWithTagsCtxf(context.Background(), slf4go_api.LogTags{"key1": "val1"}, "discarded")
+
+	assert.Equal(t, 0, extractions)
+	assert.Empty(t, fake.logs)
+}
+
+func TestTestingLogger_AfterCompletion(t *testing.T) {
+	fake := &fakeT{}
+	logger := NewTestingLogger(fake)
+	for _, cleanup := range fake.cleanups {
+		cleanup()
+	}
+
+	logger.Errorf("logged by a background goroutine")
+
+	assert.Empty(t, fake.logs)
+	assert.Empty(t, fake.errors)
+}
+
+func TestTestingLogger_CompletionWhileLogging(t *testing.T) {
+	fake := &fakeT{}
+	logger := NewTestingLogger(fake)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				logger.Infof("logged by a background goroutine")
+			}
+		}()
+	}
+	for _, cleanup := range fake.cleanups {
+		cleanup()
+	}
+	logged := len(fake.logs)
+	wg.Wait()
+
+	assert.Len(t, fake.logs, logged)
+}
+
+func TestTestingLogger_WithStaticTagsReplacesTags(t *testing.T) {
+	fake := &fakeT{}
+	logger := NewTestingLogger(fake)
+
+	logger.WithStaticTags(slf4go_api.LogTags{"service": "billing", "version": 1}).
+		WithStaticTags(slf4go_api.LogTags{"version": 2}).
+		Infof("replaced")
+
+	assert.Equal(t, []string{`info "replaced" tags={version=2}`}, fake.logs)
+}
+
+func TestTestingLogger_WritesThroughTest(t *testing.T) {
+	for _, component := range []slf4go_api.AppComponent{"billing", "shipping"} {
+		t.Run(string(component), func(t *testing.T) {
+			logger := NewTestingLogger(t, FailAtOrAbove(slf4go_api.Error))
+			logger.ForComponent(component).Infof("shown with -v under the subtest, attributed to this line")
+		})
+	}
+}
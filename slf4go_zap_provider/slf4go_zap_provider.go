@@ -0,0 +1,453 @@
+package slf4go_zap_provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// TraceLevel is the custom zap level used for slf4go_api.Trace entries. It ranks directly below
+// zapcore.DebugLevel, so the zap core must be configured with TraceLevel to emit trace entries.
+const TraceLevel = zapcore.DebugLevel - 1
+
+type Slf4GoZapLogger struct {
+	logger            *zap.Logger
+	appComponent      slf4go_api.AppComponent
+	tags              slf4go_api.LogTags
+	componentTagLabel string
+	err               error
+	reportCaller      bool
+	stackTraceLevel   slf4go_api.LogLevel
+	componentLevels   *slf4go_api.ComponentLevels
+	staticFields      []zap.Field
+	lazyStaticTags    bool
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(Slf4GoZapLogger{}).PkgPath())
+}
+
+// New creates a new Slf4GoZapLogger writing all entries to the given zap.Logger. Fatal entries terminate
+// the program via slf4go_api.Exit rather than the fatal hook of the zap.Logger.
+func New(zapLogger *zap.Logger) *Slf4GoZapLogger {
+	l := &Slf4GoZapLogger{
+		logger:            zapLogger.WithOptions(zap.WithFatalHook(writeThenReturn{})),
+		appComponent:      "",
+		tags:              make(slf4go_api.LogTags),
+		componentTagLabel: slf4go_api.DefaultAppComponentTag,
+		stackTraceLevel:   slf4go_api.NoStackTrace,
+	}
+	l.precomputeStaticFields()
+	return l
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers writing to the given zap.Logger,
+// to be installed via slf4go_api.RegisterProvider.
+func NewProvider(zapLogger *zap.Logger) slf4go_api.Provider {
+	root := New(zapLogger)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+// derive returns a copy of the logger with the given modification applied and the static fields recomputed.
+func (l *Slf4GoZapLogger) derive(modify func(derived *Slf4GoZapLogger)) *Slf4GoZapLogger {
+	derived := *l
+	modify(&derived)
+	derived.precomputeStaticFields()
+	return &derived
+}
+
+func (l *Slf4GoZapLogger) precomputeStaticFields() {
+	l.lazyStaticTags = slf4go_api.ContainsLazy(l.tags)
+	if l.lazyStaticTags {
+		// lazy static tags have to be resolved per entry and cannot be precomputed
+		l.staticFields = staticFields(l.appComponent, nil, l.componentTagLabel)
+	} else {
+		l.staticFields = staticFields(l.appComponent, combineTags(l.tags, slf4go_api.ErrorTags(l.err)), l.componentTagLabel)
+	}
+}
+
+// LevelEncoder is a zapcore.LevelEncoder that serializes TraceLevel as "trace" and
+// all other levels like zapcore.LowercaseLevelEncoder.
+func LevelEncoder(level zapcore.Level, encoder zapcore.PrimitiveArrayEncoder) {
+	if level == TraceLevel {
+		encoder.AppendString("trace")
+		return
+	}
+	zapcore.LowercaseLevelEncoder(level, encoder)
+}
+
+func (l *Slf4GoZapLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.appComponent = component })
+}
+
+func (l *Slf4GoZapLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.componentTagLabel = componentTagLabel })
+}
+
+func (l *Slf4GoZapLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.tags = tags })
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *Slf4GoZapLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *Slf4GoZapLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.err = err })
+}
+
+func (l *Slf4GoZapLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.reportCaller = enabled })
+}
+
+func (l *Slf4GoZapLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.stackTraceLevel = threshold })
+}
+
+func (l *Slf4GoZapLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	return l.derive(func(derived *Slf4GoZapLogger) { derived.componentLevels = levels })
+}
+
+func (l *Slf4GoZapLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	zapLevel, ok := toZapLevel(level)
+	return ok && l.logger.Core().Enabled(zapLevel) && l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *Slf4GoZapLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *Slf4GoZapLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *Slf4GoZapLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.zapLogWithTagsf(context.Background(), level, nil, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.zapLogWithTagsf(context.Background(), level, tags, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.zapLogWithTagsf(ctx, level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.zapLogWithTagsf(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) zapLogWithTagsf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	zapLevel, ok := toZapLevel(level)
+	if !ok {
+		l.logger.Error(fmt.Sprintf("Mapping error level '%s' onto zap level failed. Not logging event", level.String()))
+		return
+	}
+	// Fatal and Panic entries are always passed on to terminate the program or panic
+	if level > slf4go_api.Panic && !l.componentLevels.IsEnabled(l.appComponent, level) {
+		return
+	}
+	checkedEntry := l.logger.Check(zapLevel, "")
+	if checkedEntry == nil {
+		return
+	}
+	if l.reportCaller {
+		tags = combineTags(slf4go_api.CallerTags(), tags)
+	}
+	if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) {
+		tags = combineTags(slf4go_api.StackTraceTags(), tags)
+	}
+	msg := fmt.Sprintf(msgTemplate, slf4go_api.ResolveArgs(args)...)
+	checkedEntry.Message = msg
+	checkedEntry.Write(l.fields(tags)...)
+	if level == slf4go_api.Fatal {
+		slf4go_api.ExitCtx(ctx, msg)
+	}
+}
+
+// writeThenReturn is the fatal hook of the zap.Logger, leaving the termination of the program to slf4go_api.Exit.
+type writeThenReturn struct{}
+
+func (writeThenReturn) OnWrite(*zapcore.CheckedEntry, []zap.Field) {}
+
+// fields returns the precomputed static fields if there are no dynamic tags. Otherwise, the dynamic
+// tags are appended to the static fields they do not override, without merging both into a map.
+// Lazy tags are resolved against the merged static and dynamic tags, so that they override neither.
+func (l *Slf4GoZapLogger) fields(tags slf4go_api.LogTags) []zap.Field {
+	if l.lazyStaticTags || slf4go_api.ContainsLazy(tags) {
+		return staticFields(l.appComponent, slf4go_api.ResolveTags(combineTags(l.tags, slf4go_api.ErrorTags(l.err), tags)), l.componentTagLabel)
+	}
+	if len(tags) == 0 {
+		return l.staticFields
+	}
+	fields := make([]zap.Field, 0, len(l.staticFields)+len(tags))
+	for _, field := range l.staticFields {
+		if _, overridden := tags[field.Key]; overridden && field.Key != l.componentLabel() {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	for _, key := range sortedKeys(tags) {
+		if key == l.componentLabel() {
+			continue
+		}
+		fields = append(fields, field(key, tags[key]))
+	}
+	return fields
+}
+
+// componentLabel returns the label of the component field, or an empty string if there is no component.
+func (l *Slf4GoZapLogger) componentLabel() string {
+	if len(l.appComponent) == 0 {
+		return ""
+	}
+	return l.componentTagLabel
+}
+
+func staticFields(appComponent slf4go_api.AppComponent, tags slf4go_api.LogTags, componentTagLabel string) []zap.Field {
+	fields := make([]zap.Field, 0, len(tags)+1)
+	if len(appComponent) >= 1 {
+		fields = append(fields, zap.String(componentTagLabel, string(appComponent)))
+	}
+	for _, key := range sortedKeys(tags) {
+		if len(appComponent) >= 1 && key == componentTagLabel {
+			continue
+		}
+		fields = append(fields, field(key, tags[key]))
+	}
+	return fields
+}
+
+// field converts a tag into a zap field. Stack traces are encoded as arrays of frames, as zap.Any
+// would encode them as a single string via their String method.
+func field(key string, value interface{}) zap.Field {
+	if stackTrace, ok := value.(slf4go_api.StackTrace); ok {
+		return zap.Array(key, stackTraceMarshaler(stackTrace))
+	}
+	return zap.Any(key, value)
+}
+
+type stackTraceMarshaler slf4go_api.StackTrace
+
+func (m stackTraceMarshaler) MarshalLogArray(encoder zapcore.ArrayEncoder) error {
+	for _, frame := range m {
+		err := encoder.AppendObject(zapcore.ObjectMarshalerFunc(func(encoder zapcore.ObjectEncoder) error {
+			encoder.AddString("function", frame.Function)
+			encoder.AddString("file", frame.File)
+			encoder.AddInt("line", frame.Line)
+			return nil
+		}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(tags slf4go_api.LogTags) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toZapLevel(level slf4go_api.LogLevel) (zapcore.Level, bool) {
+	switch level {
+	case slf4go_api.Fatal:
+		return zapcore.FatalLevel, true
+	case slf4go_api.Panic:
+		return zapcore.PanicLevel, true
+	case slf4go_api.Error:
+		return zapcore.ErrorLevel, true
+	case slf4go_api.Warn:
+		return zapcore.WarnLevel, true
+	case slf4go_api.Info:
+		return zapcore.InfoLevel, true
+	case slf4go_api.Debug:
+		return zapcore.DebugLevel, true
+	case slf4go_api.Trace:
+		return TraceLevel, true
+	default:
+		return 0, false
+	}
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *Slf4GoZapLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZapLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
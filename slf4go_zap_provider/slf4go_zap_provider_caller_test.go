@@ -0,0 +1,47 @@
+package slf4go_zap_provider_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/MariusSchmidt/slf4go/slf4go_zap_provider"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"strings"
+	"testing"
+)
+
+// The caller tests live in an external test package, as frames of the provider package itself are skipped
+// when determining the call site.
+
+func TestStackTrace(t *testing.T) {
+	output := &bytes.Buffer{}
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig()), zapcore.AddSync(output), zapcore.InfoLevel)
+	logger := slf4go_zap_provider.New(zap.New(core)).WithStackTrace(slf4go_api.Error)
+
+	t.Run("error", func(t *testing.T) {
+		output.Reset()
+		logger.Errorf("test message")
+		entry := lastEntry(t, output)
+		stackTrace, ok := entry[slf4go_api.StackTraceTag].([]interface{})
+		if assert.True(t, ok) && assert.NotEmpty(t, stackTrace) {
+			assert.True(t, strings.HasPrefix(fmt.Sprint(stackTrace[0].(map[string]interface{})["function"]), "github.com/MariusSchmidt/slf4go/slf4go_zap_provider_test.TestStackTrace"),
+				"unexpected frame %v", stackTrace[0])
+		}
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		output.Reset()
+		logger.Warnf("test message")
+		assert.NotContains(t, lastEntry(t, output), slf4go_api.StackTraceTag)
+	})
+}
+
+func lastEntry(t *testing.T, output *bytes.Buffer) map[string]interface{} {
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	entry := make(map[string]interface{})
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), &entry))
+	return entry
+}
@@ -0,0 +1,33 @@
+package slf4go_zap_provider
+
+import (
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"os"
+)
+
+func ExampleNew() {
+	// default instantiation
+	zapLogger, _ := zap.NewProduction()
+	logger := New(zapLogger)
+	logger.Infof("Server starting...")
+}
+
+func ExampleLevelEncoder() {
+	// enable and render trace entries
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.EncodeLevel = LevelEncoder
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), zapcore.Lock(os.Stdout), TraceLevel)
+	logger := New(zap.New(core))
+	logger.Tracef("Server starting...")
+}
+
+func ExampleSlf4GoZapLogger_WithStaticTags() {
+	logger := New(zap.NewExample()).WithStaticTags(slf4go_api.LogTags{
+		"requestID": "abc-123",
+		"userID":    "user-456",
+	})
+
+	logger.Infof("Processing request...")
+}
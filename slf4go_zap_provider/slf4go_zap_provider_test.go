@@ -0,0 +1,184 @@
+package slf4go_zap_provider
+
+import (
+	"github.com/MariusSchmidt/slf4go/internal/providertest"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	providertest.Run(t, func(t *testing.T) (slf4go_api.Slf4GoLogger, providertest.Capture) {
+		setup := newTestingSetup()
+		return setup.slf4GoZapLogger, setup.entries
+	}, providertest.CapturedAs(capturedByZap))
+}
+
+// capturedByZap converts tags into the values the observer captures for the fields the provider writes: components
+// are written as strings, integers are captured as int64 and slices as []interface{}.
+func capturedByZap(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	encoder := zapcore.NewMapObjectEncoder()
+	for key, value := range providertest.ComponentsAsStrings(tags) {
+		field(key, value).AddTo(encoder)
+	}
+	return encoder.Fields
+}
+
+func TestLogging_UnknownLevel(t *testing.T) {
+	testConfig := newTestingSetup()
+	var unknownLevel slf4go_api.LogLevel = 666
+	testConfig.slf4GoZapLogger.Logf(unknownLevel, "Some message not displayed")
+	require.Equal(t, 1, testConfig.logs.Len())
+	assert.Equal(t, zapcore.ErrorLevel, testConfig.logs.All()[0].Level)
+	assert.Empty(t, testConfig.logs.All()[0].Context)
+	assert.Equal(t, "Mapping error level 'unknown' onto zap level failed. Not logging event", testConfig.logs.All()[0].Message)
+}
+
+func TestLogging_FieldOrder(t *testing.T) {
+	testConfig := newTestingSetup().
+		forComponent("test-service").
+		withStaticTags(map[string]interface{}{"b_key": "b_val", "d_key": "d_val", "c_key": "static_c_val"})
+
+	testConfig.slf4GoZapLogger.InfoWithTagsf(map[string]interface{}{"c_key": "c_val", "a_key": "a_val"}, "test message")
+
+	var keys []string
+	for _, field := range testConfig.logs.All()[0].Context {
+		keys = append(keys, field.Key)
+	}
+	assert.Equal(t, []string{slf4go_api.DefaultAppComponentTag, "b_key", "d_key", "a_key", "c_key"}, keys)
+	assert.Equal(t, "c_val", testConfig.logs.All()[0].ContextMap()["c_key"])
+}
+
+func TestLogging_StaticFieldsAreReused(t *testing.T) {
+	testConfig := newTestingSetup().
+		withStaticTags(map[string]interface{}{"key1": "val1"})
+
+	fields := testConfig.slf4GoZapLogger.fields(nil)
+	assert.Equal(t, []zap.Field{zap.Any("key1", "val1")}, fields)
+	assert.Same(t, &testConfig.slf4GoZapLogger.staticFields[0], &fields[0])
+}
+
+func TestLogging_TraceDisabled(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	slf4GoZapLogger := New(zap.New(core))
+
+	slf4GoZapLogger.Tracef("test message")
+	assert.Equal(t, 0, logs.Len())
+
+	slf4GoZapLogger.Debugf("test message")
+	assert.Equal(t, 1, logs.Len())
+}
+
+func TestLevelEncoder(t *testing.T) {
+	scenarios := []struct {
+		level    zapcore.Level
+		expected string
+	}{
+		{zapcore.FatalLevel, "fatal"},
+		{zapcore.PanicLevel, "panic"},
+		{zapcore.ErrorLevel, "error"},
+		{zapcore.WarnLevel, "warn"},
+		{zapcore.InfoLevel, "info"},
+		{zapcore.DebugLevel, "debug"},
+		{TraceLevel, "trace"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.expected, func(t *testing.T) {
+			encoder := zapcore.NewJSONEncoder(zapcore.EncoderConfig{LevelKey: "level", EncodeLevel: LevelEncoder})
+			buffer, err := encoder.EncodeEntry(zapcore.Entry{Level: scenario.level}, nil)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"level":"`+scenario.expected+`"}`, buffer.String())
+		})
+	}
+}
+
+func TestIsEnabled(t *testing.T) {
+	core, _ := observer.New(zapcore.InfoLevel)
+	slf4GoZapLogger := New(zap.New(core))
+
+	scenarios := []struct {
+		name     string
+		level    slf4go_api.LogLevel
+		expected bool
+	}{
+		{"fatal", slf4go_api.Fatal, true},
+		{"panic", slf4go_api.Panic, true},
+		{"error", slf4go_api.Error, true},
+		{"warn", slf4go_api.Warn, true},
+		{"info", slf4go_api.Info, true},
+		{"debug", slf4go_api.Debug, false},
+		{"trace", slf4go_api.Trace, false},
+		{"unknown", 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4GoZapLogger.IsEnabled(scenario.level))
+		})
+	}
+
+	t.Run("debug-and-trace", func(t *testing.T) {
+		assert.False(t, slf4GoZapLogger.IsDebugEnabled())
+		assert.False(t, slf4GoZapLogger.IsTraceEnabled())
+	})
+}
+
+type testingSetup struct {
+	slf4GoZapLogger *Slf4GoZapLogger
+	logs            *observer.ObservedLogs
+}
+
+func newTestingSetup() *testingSetup {
+	core, logs := observer.New(TraceLevel)
+	return &testingSetup{
+		slf4GoZapLogger: New(zap.New(core)),
+		logs:            logs,
+	}
+}
+
+func (setup *testingSetup) forComponent(component slf4go_api.AppComponent) *testingSetup {
+	setup.slf4GoZapLogger = setup.slf4GoZapLogger.ForComponent(component).(*Slf4GoZapLogger)
+	return setup
+}
+
+func (setup *testingSetup) withStaticTags(tags map[string]interface{}) *testingSetup {
+	setup.slf4GoZapLogger = setup.slf4GoZapLogger.WithStaticTags(tags).(*Slf4GoZapLogger)
+	return setup
+}
+
+// entries returns the entries captured by the observer, with the zap levels mapped back onto the levels logged.
+func (setup *testingSetup) entries() []providertest.Entry {
+	var entries []providertest.Entry
+	for _, captured := range setup.logs.All() {
+		entries = append(entries, providertest.Entry{
+			Level:   fromZapLevel(captured.Level),
+			Message: captured.Message,
+			Tags:    captured.ContextMap(),
+		})
+	}
+	return entries
+}
+
+func fromZapLevel(level zapcore.Level) slf4go_api.LogLevel {
+	switch {
+	case level >= zapcore.FatalLevel:
+		return slf4go_api.Fatal
+	case level >= zapcore.DPanicLevel:
+		return slf4go_api.Panic
+	case level >= zapcore.ErrorLevel:
+		return slf4go_api.Error
+	case level >= zapcore.WarnLevel:
+		return slf4go_api.Warn
+	case level >= zapcore.InfoLevel:
+		return slf4go_api.Info
+	case level >= zapcore.DebugLevel:
+		return slf4go_api.Debug
+	default:
+		return slf4go_api.Trace
+	}
+}
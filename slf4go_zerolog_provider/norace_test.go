@@ -0,0 +1,5 @@
+//go:build !race
+
+package slf4go_zerolog_provider
+
+const raceEnabled = false
@@ -0,0 +1,6 @@
+//go:build race
+
+package slf4go_zerolog_provider
+
+// raceEnabled reports whether the tests run with the race detector, which adds allocations of its own.
+const raceEnabled = true
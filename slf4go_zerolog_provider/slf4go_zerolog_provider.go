@@ -0,0 +1,428 @@
+package slf4go_zerolog_provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/rs/zerolog"
+)
+
+type Slf4GoZerologLogger struct {
+	logger            zerolog.Logger
+	staticLogger      zerolog.Logger
+	appComponent      slf4go_api.AppComponent
+	tags              slf4go_api.LogTags
+	componentTagLabel string
+	err               error
+	staticTags        slf4go_api.LogTags // tags combined with the tags of err
+	lazyStaticTags    bool
+	reportCaller      bool
+	stackTraceLevel   slf4go_api.LogLevel
+	componentLevels   *slf4go_api.ComponentLevels
+}
+
+func init() {
+	slf4go_api.RegisterLoggingPackage(reflect.TypeOf(Slf4GoZerologLogger{}).PkgPath())
+}
+
+// New creates a new Slf4GoZerologLogger writing all entries to the given zerolog.Logger
+func New(zerologLogger zerolog.Logger) *Slf4GoZerologLogger {
+	l := &Slf4GoZerologLogger{
+		logger:            zerologLogger,
+		appComponent:      "",
+		tags:              make(slf4go_api.LogTags),
+		componentTagLabel: slf4go_api.DefaultAppComponentTag,
+		stackTraceLevel:   slf4go_api.NoStackTrace,
+	}
+	l.preRenderStaticTags()
+	return l
+}
+
+// NewProvider creates a slf4go_api.Provider handing out loggers writing to the given zerolog.Logger,
+// to be installed via slf4go_api.RegisterProvider.
+func NewProvider(zerologLogger zerolog.Logger) slf4go_api.Provider {
+	root := New(zerologLogger)
+	return slf4go_api.ProviderFunc(func() slf4go_api.Slf4GoLogger {
+		return root
+	})
+}
+
+// derive returns a copy of the logger with the given modification applied and the static tags pre-rendered again.
+func (l *Slf4GoZerologLogger) derive(modify func(derived *Slf4GoZerologLogger)) *Slf4GoZerologLogger {
+	derived := *l
+	modify(&derived)
+	derived.preRenderStaticTags()
+	return &derived
+}
+
+// preRenderStaticTags renders the component, the static tags and the attached error into the context of
+// staticLogger, so that entries without dynamic tags do not have to encode them again.
+func (l *Slf4GoZerologLogger) preRenderStaticTags() {
+	l.staticTags = combineTags(l.tags, slf4go_api.ErrorTags(l.err))
+	l.lazyStaticTags = slf4go_api.ContainsLazy(l.tags)
+	staticContext := l.logger.With()
+	if len(l.appComponent) >= 1 {
+		staticContext = staticContext.Str(l.componentTagLabel, string(l.appComponent))
+	}
+	// lazy static tags have to be resolved per entry and cannot be pre-rendered
+	for _, key := range sortedKeys(l.staticTags) {
+		if !l.isComponentKey(key) && !l.lazyStaticTags {
+			staticContext = staticContext.Interface(key, l.staticTags[key])
+		}
+	}
+	l.staticLogger = staticContext.Logger()
+}
+
+func (l *Slf4GoZerologLogger) ForComponent(component slf4go_api.AppComponent) slf4go_api.Slf4GoLogger {
+	l.componentLevels.Register(component)
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.appComponent = component })
+}
+
+func (l *Slf4GoZerologLogger) WithAppComponentLabel(componentTagLabel string) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.componentTagLabel = componentTagLabel })
+}
+
+func (l *Slf4GoZerologLogger) WithStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.tags = tags })
+}
+
+// AddStaticTags adds the given tags to the static tags of the logger, see slf4go_api.AddStaticTags.
+func (l *Slf4GoZerologLogger) AddStaticTags(tags slf4go_api.LogTags) slf4go_api.Slf4GoLogger {
+	return l.WithStaticTags(combineTags(l.tags, tags))
+}
+
+func (l *Slf4GoZerologLogger) WithError(err error) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.err = err })
+}
+
+func (l *Slf4GoZerologLogger) WithCallerReporting(enabled bool) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.reportCaller = enabled })
+}
+
+func (l *Slf4GoZerologLogger) WithStackTrace(threshold slf4go_api.LogLevel) slf4go_api.Slf4GoLogger {
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.stackTraceLevel = threshold })
+}
+
+func (l *Slf4GoZerologLogger) WithComponentLevels(levels *slf4go_api.ComponentLevels) slf4go_api.Slf4GoLogger {
+	levels.Register(l.appComponent)
+	return l.derive(func(derived *Slf4GoZerologLogger) { derived.componentLevels = levels })
+}
+
+func (l *Slf4GoZerologLogger) IsEnabled(level slf4go_api.LogLevel) bool {
+	zerologLevel, ok := toZerologLevel(level)
+	return ok && zerologLevel >= l.logger.GetLevel() && zerologLevel >= zerolog.GlobalLevel() &&
+		l.componentLevels.IsEnabled(l.appComponent, level)
+}
+
+func (l *Slf4GoZerologLogger) IsDebugEnabled() bool {
+	return l.IsEnabled(slf4go_api.Debug)
+}
+
+func (l *Slf4GoZerologLogger) IsTraceEnabled() bool {
+	return l.IsEnabled(slf4go_api.Trace)
+}
+
+func (l *Slf4GoZerologLogger) Logf(level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	l.zerologLogWithTagsf(context.Background(), level, nil, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) LogWithTagsf(level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.zerologLogWithTagsf(context.Background(), level, tags, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) LogErrf(level slf4go_api.LogLevel, err error, msgTemplate string, args ...interface{}) {
+	l.WithError(err).Logf(level, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) LogCtxf(ctx context.Context, level slf4go_api.LogLevel, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.zerologLogWithTagsf(ctx, level, slf4go_api.ExtractContextTags(ctx), msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) LogWithTagsCtxf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	if level > slf4go_api.Panic && level.IsValid() && !l.IsEnabled(level) {
+		return
+	}
+	l.zerologLogWithTagsf(ctx, level, combineTags(slf4go_api.ExtractContextTags(ctx), tags), msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) zerologLogWithTagsf(ctx context.Context, level slf4go_api.LogLevel, tags slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	zerologLevel, ok := toZerologLevel(level)
+	if !ok {
+		l.logger.Error().Msgf("Mapping error level '%s' onto zerolog level failed. Not logging event", level.String())
+		return
+	}
+	if !l.IsEnabled(level) && level != slf4go_api.Fatal && level != slf4go_api.Panic {
+		return
+	}
+	if l.reportCaller {
+		tags = combineTags(slf4go_api.CallerTags(), tags)
+	}
+	if slf4go_api.CapturesStackTrace(level, l.stackTraceLevel) && l.IsEnabled(level) {
+		tags = combineTags(slf4go_api.StackTraceTags(), tags)
+	}
+	msg := fmt.Sprintf(msgTemplate, slf4go_api.ResolveArgs(args)...)
+	if event := l.event(zerologLevel, tags); event != nil {
+		event.Msg(msg)
+	}
+	switch level {
+	case slf4go_api.Fatal:
+		slf4go_api.ExitCtx(ctx, msg)
+	case slf4go_api.Panic:
+		panic(msg)
+	}
+}
+
+// event starts a new event at the given level. Without dynamic tags, the event is created from the
+// pre-rendered static logger and no further fields have to be encoded. Dynamic tags overriding static
+// tags require all tags to be encoded again, as zerolog does not deduplicate keys.
+func (l *Slf4GoZerologLogger) event(level zerolog.Level, tags slf4go_api.LogTags) *zerolog.Event {
+	if l.lazyStaticTags {
+		return addTags(l.staticLogger.WithLevel(level), slf4go_api.ResolveTags(combineTags(l.staticTags, tags)), l.isComponentKey)
+	}
+	tags = l.resolveDynamicTags(tags)
+	if len(tags) == 0 {
+		return l.staticLogger.WithLevel(level)
+	}
+	if !l.overridesStaticTags(tags) {
+		return addTags(l.staticLogger.WithLevel(level), tags, l.isComponentKey)
+	}
+	event := l.logger.WithLevel(level)
+	if event != nil && len(l.appComponent) >= 1 {
+		event = event.Str(l.componentTagLabel, string(l.appComponent))
+	}
+	return addTags(event, combineTags(l.staticTags, tags), l.isComponentKey)
+}
+
+// resolveDynamicTags resolves lazy tags against the static and the dynamic tags, so that they override neither,
+// and returns the dynamic tags including the resolved ones.
+func (l *Slf4GoZerologLogger) resolveDynamicTags(tags slf4go_api.LogTags) slf4go_api.LogTags {
+	if !slf4go_api.ContainsLazy(tags) {
+		return tags
+	}
+	resolved := slf4go_api.ResolveTags(combineTags(l.staticTags, tags))
+	for key := range l.staticTags {
+		if _, dynamic := tags[key]; !dynamic {
+			delete(resolved, key)
+		}
+	}
+	return resolved
+}
+
+func (l *Slf4GoZerologLogger) overridesStaticTags(tags slf4go_api.LogTags) bool {
+	for key := range tags {
+		if _, ok := l.staticTags[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Slf4GoZerologLogger) isComponentKey(key string) bool {
+	return len(l.appComponent) >= 1 && key == l.componentTagLabel
+}
+
+func addTags(event *zerolog.Event, tags slf4go_api.LogTags, skip func(string) bool) *zerolog.Event {
+	if event == nil {
+		return nil
+	}
+	for _, key := range sortedKeys(tags) {
+		if !skip(key) {
+			event = event.Interface(key, tags[key])
+		}
+	}
+	return event
+}
+
+func sortedKeys(tags slf4go_api.LogTags) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toZerologLevel(level slf4go_api.LogLevel) (zerolog.Level, bool) {
+	switch level {
+	case slf4go_api.Fatal:
+		return zerolog.FatalLevel, true
+	case slf4go_api.Panic:
+		return zerolog.PanicLevel, true
+	case slf4go_api.Error:
+		return zerolog.ErrorLevel, true
+	case slf4go_api.Warn:
+		return zerolog.WarnLevel, true
+	case slf4go_api.Info:
+		return zerolog.InfoLevel, true
+	case slf4go_api.Debug:
+		return zerolog.DebugLevel, true
+	case slf4go_api.Trace:
+		return zerolog.TraceLevel, true
+	default:
+		return 0, false
+	}
+}
+
+func combineTags(tags ...slf4go_api.LogTags) slf4go_api.LogTags {
+	merged := make(slf4go_api.LogTags)
+	for _, m := range tags {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
+func (l *Slf4GoZerologLogger) Tracef(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Debugf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Infof(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Warnf(msgTemplate string, args ...interface{}) {
+	l.Warningf(msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Warningf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Errorf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Panicf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) Fatalf(msgTemplate string, args ...interface{}) {
+	l.Logf(slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) TraceWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) DebugWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) InfoWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarnWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsf(fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarningWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) ErrorWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) PanicWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) FatalWithTagsf(fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsf(slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) TraceCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Trace, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) DebugCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Debug, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) InfoCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Info, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarnCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.WarningCtxf(ctx, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarningCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Warn, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) ErrorCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Error, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) PanicCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Panic, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) FatalCtxf(ctx context.Context, msgTemplate string, args ...interface{}) {
+	l.LogCtxf(ctx, slf4go_api.Fatal, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) TraceWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Trace, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) DebugWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Debug, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) InfoWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Info, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarnWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.WarningWithTagsCtxf(ctx, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarningWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Warn, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) ErrorWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Error, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) PanicWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Panic, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) FatalWithTagsCtxf(ctx context.Context, fields slf4go_api.LogTags, msgTemplate string, args ...interface{}) {
+	l.LogWithTagsCtxf(ctx, slf4go_api.Fatal, fields, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarnErrf(err error, msgTemplate string, args ...interface{}) {
+	l.WarningErrf(err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) WarningErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Warn, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) ErrorErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Error, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) PanicErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Panic, err, msgTemplate, args...)
+}
+
+func (l *Slf4GoZerologLogger) FatalErrf(err error, msgTemplate string, args ...interface{}) {
+	l.LogErrf(slf4go_api.Fatal, err, msgTemplate, args...)
+}
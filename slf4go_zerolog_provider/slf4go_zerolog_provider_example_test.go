@@ -0,0 +1,28 @@
+package slf4go_zerolog_provider
+
+import (
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/rs/zerolog"
+	"os"
+)
+
+func ExampleNew() {
+	// default instantiation
+	logger := New(zerolog.New(os.Stdout).With().Timestamp().Logger())
+	logger.Infof("Server starting...")
+}
+
+func ExampleSlf4GoZerologLogger_WithAppComponentLabel() {
+	// with custom Component-Label
+	logger := New(zerolog.New(os.Stdout)).ForComponent("service").WithAppComponentLabel("component")
+	logger.Infof("Server starting...")
+}
+
+func ExampleSlf4GoZerologLogger_WithStaticTags() {
+	logger := New(zerolog.New(os.Stdout)).WithStaticTags(slf4go_api.LogTags{
+		"requestID": "abc-123",
+		"userID":    "user-456",
+	})
+
+	logger.Infof("Processing request...")
+}
@@ -0,0 +1,107 @@
+package slf4go_zerolog_provider
+
+import (
+	"bytes"
+	"github.com/MariusSchmidt/slf4go/internal/providertest"
+	"github.com/MariusSchmidt/slf4go/slf4go_api"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestConformance(t *testing.T) {
+	providertest.Run(t, func(t *testing.T) (slf4go_api.Slf4GoLogger, providertest.Capture) {
+		setup := newTestingSetup()
+		return setup.slf4GoZerologLogger, providertest.JSONLines(setup.output, zerolog.LevelFieldName, zerolog.MessageFieldName)
+	}, providertest.CapturedAs(providertest.JSONValues))
+}
+
+func TestLogging_UnknownLevel(t *testing.T) {
+	testConfig := newTestingSetup()
+	var unknownLevel slf4go_api.LogLevel = 666
+	testConfig.slf4GoZerologLogger.Logf(unknownLevel, "Some message not displayed")
+	assert.Equal(t, `{"level":"error","message":"Mapping error level 'unknown' onto zerolog level failed. Not logging event"}`+"\n", testConfig.output.String())
+}
+
+func TestLogging_NoDuplicateKeys(t *testing.T) {
+	testConfig := newTestingSetup().
+		forComponent("test-service").
+		withStaticTags(map[string]interface{}{"key1": "val1", "key2": "val2"})
+
+	testConfig.slf4GoZerologLogger.InfoWithTagsf(map[string]interface{}{"key2": "dyn_val2", "appComponent": "other"}, "test message")
+
+	assert.Equal(t, `{"level":"info","appComponent":"test-service","key1":"val1","key2":"dyn_val2","message":"test message"}`+"\n", testConfig.output.String())
+}
+
+func TestLogging_Allocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector adds allocations")
+	}
+	zerologLogger := zerolog.New(io.Discard)
+	slf4GoZerologLogger := New(zerologLogger).
+		ForComponent("test-service").
+		WithStaticTags(map[string]interface{}{"key1": "val1"})
+
+	zerologAllocs := testing.AllocsPerRun(100, func() {
+		zerologLogger.Info().Msgf("test message")
+	})
+	slf4GoAllocs := testing.AllocsPerRun(100, func() {
+		slf4GoZerologLogger.Infof("test message")
+	})
+	assert.Equal(t, zerologAllocs, slf4GoAllocs)
+}
+
+func TestIsEnabled(t *testing.T) {
+	slf4GoZerologLogger := New(zerolog.New(io.Discard).Level(zerolog.InfoLevel))
+
+	scenarios := []struct {
+		name     string
+		level    slf4go_api.LogLevel
+		expected bool
+	}{
+		{"fatal", slf4go_api.Fatal, true},
+		{"panic", slf4go_api.Panic, true},
+		{"error", slf4go_api.Error, true},
+		{"warn", slf4go_api.Warn, true},
+		{"info", slf4go_api.Info, true},
+		{"debug", slf4go_api.Debug, false},
+		{"trace", slf4go_api.Trace, false},
+		{"unknown", 666, false},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Equal(t, scenario.expected, slf4GoZerologLogger.IsEnabled(scenario.level))
+		})
+	}
+
+	t.Run("debug-and-trace", func(t *testing.T) {
+		assert.False(t, slf4GoZerologLogger.IsDebugEnabled())
+		assert.False(t, slf4GoZerologLogger.IsTraceEnabled())
+	})
+}
+
+type testingSetup struct {
+	slf4GoZerologLogger *Slf4GoZerologLogger
+	output              *bytes.Buffer
+}
+
+func newTestingSetup() *testingSetup {
+	output := &bytes.Buffer{}
+	slf4GoZerologLogger := New(zerolog.New(output).Level(zerolog.TraceLevel))
+	return &testingSetup{
+		slf4GoZerologLogger: slf4GoZerologLogger,
+		output:              output,
+	}
+}
+
+func (setup *testingSetup) forComponent(component slf4go_api.AppComponent) *testingSetup {
+	setup.slf4GoZerologLogger = setup.slf4GoZerologLogger.ForComponent(component).(*Slf4GoZerologLogger)
+	return setup
+}
+
+func (setup *testingSetup) withStaticTags(tags map[string]interface{}) *testingSetup {
+	setup.slf4GoZerologLogger = setup.slf4GoZerologLogger.WithStaticTags(tags).(*Slf4GoZerologLogger)
+	return setup
+}